@@ -1,6 +1,15 @@
 package twitterscraper
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type friendships struct {
 	ID          int64  `json:"id"`
@@ -84,17 +93,23 @@ type friendships struct {
 	RequireSomeConsent             bool          `json:"require_some_consent"`
 }
 
+// Follow a user, requires cookie authentication
 func (s *Scraper) Follow(user string) (*friendships, error) {
-	if s.xCsrfToken == "" || s.cookie == "" {
-		return nil, fmt.Errorf("xCsrfToken or cookie not set")
+	return s.FollowWithContext(context.Background(), user)
+}
+
+// FollowWithContext a user, requires cookie authentication
+func (s *Scraper) FollowWithContext(ctx context.Context, user string) (*friendships, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, err
 	}
 
-	req, err := s.newRequest("POST", "https://twitter.com/i/api/1.1/friendships/create.json")
+	req, err := s.newRequest(ctx, "POST", s.endpointURL(EndpointFriendshipsCreate))
 	if err != nil {
 		return nil, err
 	}
 
-	u, err := s.GetProfile(user)
+	u, err := s.GetProfileWithContext(ctx, user)
 	if err != nil {
 		return nil, err
 	}
@@ -116,17 +131,23 @@ func (s *Scraper) Follow(user string) (*friendships, error) {
 	return &friendships, nil
 }
 
+// Unfollow a user, requires cookie authentication
 func (s *Scraper) Unfollow(user string) (*friendships, error) {
-	if s.xCsrfToken == "" || s.cookie == "" {
-		return nil, fmt.Errorf("xCsrfToken or cookie not set")
+	return s.UnfollowWithContext(context.Background(), user)
+}
+
+// UnfollowWithContext a user, requires cookie authentication
+func (s *Scraper) UnfollowWithContext(ctx context.Context, user string) (*friendships, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, err
 	}
 
-	req, err := s.newRequest("POST", "https://twitter.com/i/api/1.1/friendships/destroy.json")
+	req, err := s.newRequest(ctx, "POST", s.endpointURL(EndpointFriendshipsDestroy))
 	if err != nil {
 		return nil, err
 	}
 
-	u, err := s.GetProfile(user)
+	u, err := s.GetProfileWithContext(ctx, user)
 	if err != nil {
 		return nil, err
 	}
@@ -147,3 +168,540 @@ func (s *Scraper) Unfollow(user string) (*friendships, error) {
 
 	return &friendships, nil
 }
+
+// ListMode of list visibility
+type ListMode string
+
+const (
+	// ListPublic - list visible to everyone
+	ListPublic ListMode = "public"
+	// ListPrivate - list visible to owner only
+	ListPrivate ListMode = "private"
+)
+
+// ListParams of created or updated list
+type ListParams struct {
+	// Name of list, left unchanged by UpdateList if empty
+	Name string
+	// Description of list, left unchanged by UpdateList if empty
+	Description string
+	// Mode of list, public for CreateList and left unchanged by UpdateList if empty
+	Mode ListMode
+}
+
+func (p ListParams) values() url.Values {
+	values := url.Values{}
+	if p.Name != "" {
+		values.Set("name", p.Name)
+	}
+	if p.Description != "" {
+		values.Set("description", p.Description)
+	}
+	if p.Mode != "" {
+		values.Set("mode", string(p.Mode))
+	}
+	return values
+}
+
+// CreateList of authenticated user, requires cookie authentication
+func (s *Scraper) CreateList(params ListParams) (*List, error) {
+	return s.CreateListWithContext(context.Background(), params)
+}
+
+// CreateListWithContext of authenticated user, requires cookie authentication
+func (s *Scraper) CreateListWithContext(ctx context.Context, params ListParams) (*List, error) {
+	if params.Name == "" {
+		return nil, fmt.Errorf("list name is empty")
+	}
+	if params.Mode == "" {
+		params.Mode = ListPublic
+	}
+	return s.listRequest(ctx, EndpointListCreate, params.values())
+}
+
+// UpdateList name, description and mode, requires cookie authentication
+func (s *Scraper) UpdateList(id string, params ListParams) (*List, error) {
+	return s.UpdateListWithContext(context.Background(), id, params)
+}
+
+// UpdateListWithContext name, description and mode, requires cookie authentication
+func (s *Scraper) UpdateListWithContext(ctx context.Context, id string, params ListParams) (*List, error) {
+	values := params.values()
+	values.Set("list_id", id)
+	return s.listRequest(ctx, EndpointListUpdate, values)
+}
+
+// DeleteList of authenticated user, requires cookie authentication
+func (s *Scraper) DeleteList(id string) (*List, error) {
+	return s.DeleteListWithContext(context.Background(), id)
+}
+
+// DeleteListWithContext of authenticated user, requires cookie authentication
+func (s *Scraper) DeleteListWithContext(ctx context.Context, id string) (*List, error) {
+	return s.listRequest(ctx, EndpointListDestroy, url.Values{"list_id": {id}})
+}
+
+// AddListMember add user to list, requires cookie authentication
+func (s *Scraper) AddListMember(id string, user string) (*List, error) {
+	return s.AddListMemberWithContext(context.Background(), id, user)
+}
+
+// AddListMemberWithContext add user to list, requires cookie authentication
+func (s *Scraper) AddListMemberWithContext(ctx context.Context, id string, user string) (*List, error) {
+	return s.listMemberRequest(ctx, EndpointListMembersCreate, id, user)
+}
+
+// RemoveListMember remove user from list, requires cookie authentication
+func (s *Scraper) RemoveListMember(id string, user string) (*List, error) {
+	return s.RemoveListMemberWithContext(context.Background(), id, user)
+}
+
+// RemoveListMemberWithContext remove user from list, requires cookie authentication
+func (s *Scraper) RemoveListMemberWithContext(ctx context.Context, id string, user string) (*List, error) {
+	return s.listMemberRequest(ctx, EndpointListMembersDestroy, id, user)
+}
+
+// SubscribeList subscribe to list, requires cookie authentication
+func (s *Scraper) SubscribeList(id string) (*List, error) {
+	return s.SubscribeListWithContext(context.Background(), id)
+}
+
+// SubscribeListWithContext subscribe to list, requires cookie authentication
+func (s *Scraper) SubscribeListWithContext(ctx context.Context, id string) (*List, error) {
+	return s.listRequest(ctx, EndpointListSubscribe, url.Values{"list_id": {id}})
+}
+
+func (s *Scraper) listMemberRequest(ctx context.Context, endpoint Endpoint, id string, user string) (*List, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, err
+	}
+
+	userID, err := s.GetUserIDByScreenNameWithContext(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listRequest(ctx, endpoint, url.Values{"list_id": {id}, "user_id": {userID}})
+}
+
+// listRequest post list operation and return updated list
+func (s *Scraper) listRequest(ctx context.Context, endpoint Endpoint, values url.Values) (*List, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.newRequest(ctx, "POST", s.endpointURL(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	for key, value := range values {
+		q[key] = value
+	}
+	req.URL.RawQuery = q.Encode()
+
+	var jsn restList
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+
+	list := parseRestList(jsn)
+	return &list, nil
+}
+
+// TweetDraft of created tweet
+type TweetDraft struct {
+	Text string
+	// InReplyToID of replied tweet
+	InReplyToID string
+	// QuoteURL of quoted tweet, e.g. https://twitter.com/Twitter/status/1
+	QuoteURL string
+	// MediaIDs of uploaded media
+	MediaIDs []string
+	Poll     *TweetPoll
+}
+
+// TweetPoll of created tweet with 2 to 4 choices
+type TweetPoll struct {
+	Choices []string
+	// Duration of poll, 1 day if zero
+	Duration time.Duration
+}
+
+type createTweetResult struct {
+	Data struct {
+		CreateTweet struct {
+			TweetResults struct {
+				Result *tweetResult `json:"result"`
+			} `json:"tweet_results"`
+		} `json:"create_tweet"`
+	} `json:"data"`
+	Errors []APIErrorDetail `json:"errors"`
+}
+
+type deleteTweetResult struct {
+	Data struct {
+		DeleteTweet *struct{} `json:"delete_tweet"`
+	} `json:"data"`
+	Errors []APIErrorDetail `json:"errors"`
+}
+
+// CreateTweet post tweet, reply or quote, requires cookie authentication
+func (s *Scraper) CreateTweet(ctx context.Context, draft TweetDraft) (*Tweet, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, err
+	}
+	if draft.Text == "" && len(draft.MediaIDs) == 0 {
+		return nil, fmt.Errorf("tweet has neither text nor media")
+	}
+
+	var cardURI string
+	if draft.Poll != nil {
+		if len(draft.MediaIDs) > 0 {
+			return nil, fmt.Errorf("tweet can't have both poll and media")
+		}
+		var err error
+		cardURI, err = s.createPoll(ctx, draft.Poll)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	mediaEntities := make([]interface{}, 0, len(draft.MediaIDs))
+	for _, id := range draft.MediaIDs {
+		mediaEntities = append(mediaEntities, map[string]interface{}{
+			"media_id":     id,
+			"tagged_users": []string{},
+		})
+	}
+	variables := map[string]interface{}{
+		"tweet_text":   draft.Text,
+		"dark_request": false,
+		"media": map[string]interface{}{
+			"media_entities":     mediaEntities,
+			"possibly_sensitive": false,
+		},
+		"semantic_annotation_ids": []string{},
+	}
+	if draft.InReplyToID != "" {
+		variables["reply"] = map[string]interface{}{
+			"in_reply_to_tweet_id":   draft.InReplyToID,
+			"exclude_reply_user_ids": []string{},
+		}
+	}
+	if draft.QuoteURL != "" {
+		variables["attachment_url"] = draft.QuoteURL
+	}
+	if cardURI != "" {
+		variables["card_uri"] = cardURI
+	}
+
+	req, err := s.newGraphQLMutation(ctx, EndpointCreateTweet, variables, graphQLFeatures)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsn createTweetResult
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := graphQLError(jsn.Errors); err != nil {
+		return nil, err
+	}
+
+	tweet := parseTweetResult(jsn.Data.CreateTweet.TweetResults.Result)
+	if tweet == nil {
+		return nil, fmt.Errorf("created tweet not found in response")
+	}
+	return tweet, nil
+}
+
+// createPoll card and return its URI
+func (s *Scraper) createPoll(ctx context.Context, poll *TweetPoll) (string, error) {
+	if len(poll.Choices) < 2 || len(poll.Choices) > 4 {
+		return "", fmt.Errorf("poll must have 2 to 4 choices, got %d", len(poll.Choices))
+	}
+	duration := poll.Duration
+	if duration == 0 {
+		duration = 24 * time.Hour
+	}
+
+	card := map[string]interface{}{
+		"twitter:card":                  fmt.Sprintf("poll%dchoice_text_only", len(poll.Choices)),
+		"twitter:api:api:endpoint":      "1",
+		"twitter:long:duration_minutes": int(duration / time.Minute),
+	}
+	for i, choice := range poll.Choices {
+		card["twitter:string:choice"+strconv.Itoa(i+1)+"_label"] = choice
+	}
+	cardData, err := json.Marshal(card)
+	if err != nil {
+		return "", err
+	}
+
+	body := url.Values{"card_data": {string(cardData)}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, "POST", s.endpointURL(EndpointCardsCreate), strings.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var jsn struct {
+		CardURI string `json:"card_uri"`
+	}
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return "", err
+	}
+	if jsn.CardURI == "" {
+		return "", fmt.Errorf("poll card not created")
+	}
+	return jsn.CardURI, nil
+}
+
+// DeleteTweet of authenticated user, requires cookie authentication
+func (s *Scraper) DeleteTweet(id string) error {
+	return s.DeleteTweetWithContext(context.Background(), id)
+}
+
+// DeleteTweetWithContext of authenticated user, requires cookie authentication
+func (s *Scraper) DeleteTweetWithContext(ctx context.Context, id string) error {
+	if err := s.checkAuth(); err != nil {
+		return err
+	}
+
+	req, err := s.newGraphQLMutation(ctx, EndpointDeleteTweet, map[string]interface{}{
+		"tweet_id":     id,
+		"dark_request": false,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	var jsn deleteTweetResult
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return err
+	}
+	if err := graphQLError(jsn.Errors); err != nil {
+		return err
+	}
+	if jsn.Data.DeleteTweet == nil {
+		return fmt.Errorf("tweet %s not deleted", id)
+	}
+	return nil
+}
+
+// restTweet JSON object of 1.1 API
+type restTweet struct {
+	legacyTweet
+	IDStr           string     `json:"id_str"`
+	User            legacyUser `json:"user"`
+	RetweetedStatus *restTweet `json:"retweeted_status"`
+	QuotedStatus    *restTweet `json:"quoted_status"`
+}
+
+// addRestTweet with its author, quoted and retweeted tweets to global objects, returns tweet ID
+func (tl *timeline) addRestTweet(tweet *restTweet) string {
+	if tweet == nil || tweet.IDStr == "" {
+		return ""
+	}
+	legacy := tweet.legacyTweet
+	if tweet.User.IDStr != "" {
+		tl.GlobalObjects.Users[tweet.User.IDStr] = tweet.User
+		legacy.UserIDStr = tweet.User.IDStr
+	}
+	if id := tl.addRestTweet(tweet.RetweetedStatus); id != "" {
+		legacy.RetweetedStatusIDStr = id
+	}
+	if id := tl.addRestTweet(tweet.QuotedStatus); id != "" {
+		legacy.QuotedStatusIDStr = id
+	}
+	tl.GlobalObjects.Tweets[tweet.IDStr] = legacy
+	return tweet.IDStr
+}
+
+// parseRestTweet of 1.1 API response, parsed as timeline tweet
+func parseRestTweet(tweet *restTweet) *Tweet {
+	var tl timeline
+	tl.GlobalObjects.Tweets = make(map[string]legacyTweet)
+	tl.GlobalObjects.Users = make(map[string]legacyUser)
+	return tl.parseTweet(tl.addRestTweet(tweet))
+}
+
+// Like a tweet, requires cookie authentication
+func (s *Scraper) Like(id string) (*Tweet, error) {
+	return s.LikeWithContext(context.Background(), id)
+}
+
+// LikeWithContext a tweet, requires cookie authentication
+func (s *Scraper) LikeWithContext(ctx context.Context, id string) (*Tweet, error) {
+	ctx, tweet, err := s.actionTweet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tweet.IsLiked {
+		return nil, fmt.Errorf("tweet %s is already liked: %w", id, ErrAlreadyDone)
+	}
+	return s.tweetRequest(ctx, s.endpointURL(EndpointFavoritesCreate), id)
+}
+
+// Unlike a tweet, requires cookie authentication
+func (s *Scraper) Unlike(id string) (*Tweet, error) {
+	return s.UnlikeWithContext(context.Background(), id)
+}
+
+// UnlikeWithContext a tweet, requires cookie authentication
+func (s *Scraper) UnlikeWithContext(ctx context.Context, id string) (*Tweet, error) {
+	ctx, tweet, err := s.actionTweet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !tweet.IsLiked {
+		return nil, fmt.Errorf("tweet %s is not liked: %w", id, ErrAlreadyDone)
+	}
+	return s.tweetRequest(ctx, s.endpointURL(EndpointFavoritesDestroy), id)
+}
+
+// Retweet a tweet and return the retweet, requires cookie authentication
+func (s *Scraper) Retweet(id string) (*Tweet, error) {
+	return s.RetweetWithContext(context.Background(), id)
+}
+
+// RetweetWithContext a tweet and return the retweet, requires cookie authentication
+func (s *Scraper) RetweetWithContext(ctx context.Context, id string) (*Tweet, error) {
+	ctx, tweet, err := s.actionTweet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tweet.IsRetweeted {
+		return nil, fmt.Errorf("tweet %s is already retweeted: %w", id, ErrAlreadyDone)
+	}
+	return s.tweetRequest(ctx, s.endpointURL(EndpointRetweet, id), id)
+}
+
+// Unretweet a tweet and return the original tweet, requires cookie authentication
+func (s *Scraper) Unretweet(id string) (*Tweet, error) {
+	return s.UnretweetWithContext(context.Background(), id)
+}
+
+// UnretweetWithContext a tweet and return the original tweet, requires cookie authentication
+func (s *Scraper) UnretweetWithContext(ctx context.Context, id string) (*Tweet, error) {
+	ctx, tweet, err := s.actionTweet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !tweet.IsRetweeted {
+		return nil, fmt.Errorf("tweet %s is not retweeted: %w", id, ErrAlreadyDone)
+	}
+	return s.tweetRequest(ctx, s.endpointURL(EndpointUnretweet, id), id)
+}
+
+// PinTweet of authenticated user to profile, requires cookie authentication
+func (s *Scraper) PinTweet(id string) (*Tweet, error) {
+	return s.PinTweetWithContext(context.Background(), id)
+}
+
+// PinTweetWithContext of authenticated user to profile, requires cookie authentication
+func (s *Scraper) PinTweetWithContext(ctx context.Context, id string) (*Tweet, error) {
+	return s.pinRequest(ctx, EndpointPinTweet, id, true)
+}
+
+// UnpinTweet of authenticated user from profile, requires cookie authentication
+func (s *Scraper) UnpinTweet(id string) (*Tweet, error) {
+	return s.UnpinTweetWithContext(context.Background(), id)
+}
+
+// UnpinTweetWithContext of authenticated user from profile, requires cookie authentication
+func (s *Scraper) UnpinTweetWithContext(ctx context.Context, id string) (*Tweet, error) {
+	return s.pinRequest(ctx, EndpointUnpinTweet, id, false)
+}
+
+// actionTweet bind ctx to one session and get tweet with its state before action
+func (s *Scraper) actionTweet(ctx context.Context, id string) (context.Context, *Tweet, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, nil, err
+	}
+	ctx, err := s.withSession(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	tweet, err := s.GetTweetWithContext(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ctx, tweet, nil
+}
+
+// tweetRequest post tweet action and return tweet of response
+func (s *Scraper) tweetRequest(ctx context.Context, endpointURL string, id string) (*Tweet, error) {
+	req, err := s.newRequest(ctx, "POST", endpointURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("id", id)
+	req.URL.RawQuery = q.Encode()
+
+	var jsn restTweet
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+
+	tweet := parseRestTweet(&jsn)
+	if tweet == nil {
+		return nil, fmt.Errorf("tweet %s not found in response", id)
+	}
+	return tweet, nil
+}
+
+// pinRequest pin or unpin tweet of authenticated user and return the tweet
+func (s *Scraper) pinRequest(ctx context.Context, endpoint Endpoint, id string, pin bool) (*Tweet, error) {
+	ctx, err := s.withSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.verifyCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := stringInSlice(id, user.PinnedTweetIdsStr)
+	if pin && pinned {
+		return nil, fmt.Errorf("tweet %s is already pinned: %w", id, ErrAlreadyDone)
+	}
+	if !pin && !pinned {
+		return nil, fmt.Errorf("tweet %s is not pinned: %w", id, ErrAlreadyDone)
+	}
+
+	tweet, err := s.GetTweetWithContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tweet.UserID != user.IDStr {
+		return nil, fmt.Errorf("tweet %s: %w", id, ErrNotOwned)
+	}
+
+	req, err := s.newRequest(ctx, "POST", s.endpointURL(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("id", id)
+	req.URL.RawQuery = q.Encode()
+
+	var jsn interface{}
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+
+	tweet.IsPin = pin
+	return tweet, nil
+}
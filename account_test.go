@@ -0,0 +1,266 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+	"github.com/google/go-cmp/cmp"
+)
+
+// graphQLMutation decode body of GraphQL mutation request
+func graphQLMutation(t *testing.T, r *http.Request) (string, map[string]interface{}) {
+	if r.Method != "POST" || r.Header.Get("x-csrf-token") != "csrf" {
+		t.Errorf("Expected authenticated POST, got %s with csrf %q", r.Method, r.Header.Get("x-csrf-token"))
+	}
+	var body struct {
+		QueryID   string                 `json:"queryId"`
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		t.Error(err)
+	}
+	return body.QueryID, body.Variables
+}
+
+func TestCreateTweet(t *testing.T) {
+	var created []map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/graphql/SoVnbfCycZ7fERGCwpZkYA/CreateTweet", func(w http.ResponseWriter, r *http.Request) {
+		_, variables := graphQLMutation(t, r)
+		created = append(created, variables)
+		fmt.Fprintf(w, `{"data":{"create_tweet":{"tweet_results":{"result":%s}}}}`, tweetResult("900", variables["tweet_text"].(string)))
+	})
+	mux.HandleFunc("/v2/cards/create.json", func(w http.ResponseWriter, r *http.Request) {
+		var card map[string]interface{}
+		if err := json.Unmarshal([]byte(r.FormValue("card_data")), &card); err != nil {
+			t.Error(err)
+		}
+		expected := map[string]interface{}{
+			"twitter:card":                  "poll2choice_text_only",
+			"twitter:api:api:endpoint":      "1",
+			"twitter:long:duration_minutes": 60.0,
+			"twitter:string:choice1_label":  "yes",
+			"twitter:string:choice2_label":  "no",
+		}
+		if diff := cmp.Diff(expected, card); diff != "" {
+			t.Error("Unexpected poll card", diff)
+		}
+		fmt.Fprint(w, `{"card_uri":"card://1"}`)
+	})
+	mux.HandleFunc("/i/api/graphql/VaenaVgh5q5ih7kvyVjgtg/DeleteTweet", func(w http.ResponseWriter, r *http.Request) {
+		if _, variables := graphQLMutation(t, r); variables["tweet_id"] != "900" {
+			t.Errorf("Expected deleted tweet 900, got %v", variables["tweet_id"])
+		}
+		fmt.Fprint(w, `{"data":{"delete_tweet":{"tweet_results":{}}}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+	ctx := context.Background()
+
+	if _, err := scraper.CreateTweet(ctx, twitterscraper.TweetDraft{Text: "hello"}); !errors.Is(err, twitterscraper.ErrAuthRequired) {
+		t.Errorf("Expected ErrAuthRequired without cookies, got %v", err)
+	}
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	tweet, err := scraper.CreateTweet(ctx, twitterscraper.TweetDraft{
+		Text:        "hello",
+		InReplyToID: "1",
+		QuoteURL:    "https://twitter.com/Twitter/status/2",
+		MediaIDs:    []string{"3"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tweet.ID != "900" || tweet.Text != "hello" || tweet.Username != "author" {
+		t.Errorf("Unexpected tweet %+v", tweet)
+	}
+	reply := created[0]["reply"].(map[string]interface{})
+	media := created[0]["media"].(map[string]interface{})["media_entities"].([]interface{})
+	if reply["in_reply_to_tweet_id"] != "1" || created[0]["attachment_url"] != "https://twitter.com/Twitter/status/2" ||
+		len(media) != 1 || media[0].(map[string]interface{})["media_id"] != "3" {
+		t.Errorf("Unexpected tweet variables %v", created[0])
+	}
+
+	_, err = scraper.CreateTweet(ctx, twitterscraper.TweetDraft{
+		Text: "poll",
+		Poll: &twitterscraper.TweetPoll{Choices: []string{"yes", "no"}, Duration: time.Hour},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created[1]["card_uri"] != "card://1" {
+		t.Errorf("Expected poll card URI, got %v", created[1]["card_uri"])
+	}
+
+	if _, err := scraper.CreateTweet(ctx, twitterscraper.TweetDraft{}); err == nil {
+		t.Error("Expected error of empty tweet")
+	}
+	if err := scraper.DeleteTweet("900"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEngagementActions(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	tweets := map[string]string{
+		"1": `{"id_str":"1","full_text":"mine","user_id_str":"10","retweeted":true}`,
+		"2": `{"id_str":"2","full_text":"liked","user_id_str":"20","favorited":true}`,
+		"3": `{"id_str":"3","full_text":"pinned","user_id_str":"10"}`,
+	}
+	for id, tweet := range tweets {
+		id, tweet := id, tweet
+		mux.HandleFunc("/i/api/2/timeline/conversation/"+id+".json", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"globalObjects":{"tweets":{%q:%s},"users":{"10":{"id_str":"10","screen_name":"alice"},`+
+				`"20":{"id_str":"20","screen_name":"bob"}}},"timeline":{"instructions":[{"addEntries":{"entries":[`+
+				`{"entryId":"tweet-%[1]s","content":{"item":{"content":{"tweet":{"id":%[1]q}}}}}]}}]}}`, id, tweet)
+		})
+	}
+	mux.HandleFunc("/1.1/account/verify_credentials.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id_str":"10","screen_name":"alice","pinned_tweet_ids_str":["3"]}`)
+	})
+	for _, path := range []string{"favorites/create", "favorites/destroy", "statuses/retweet/1", "statuses/retweet/2",
+		"statuses/unretweet/1", "account/pin_tweet", "account/unpin_tweet"} {
+		path := path
+		mux.HandleFunc("/i/api/1.1/"+path+".json", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "POST" || r.Header.Get("x-csrf-token") != "csrf" {
+				t.Errorf("Expected authenticated POST, got %s with csrf %q", r.Method, r.Header.Get("x-csrf-token"))
+			}
+			id := r.URL.Query().Get("id")
+			requests = append(requests, path+":"+id)
+			switch path {
+			case "statuses/retweet/2":
+				fmt.Fprint(w, `{"id_str":"99","full_text":"RT @bob: liked","retweeted":true,"user":{"id_str":"10","screen_name":"alice"},`+
+					`"retweeted_status":{"id_str":"2","full_text":"liked","retweeted":true,"user":{"id_str":"20","screen_name":"bob"}}}`)
+			case "account/pin_tweet", "account/unpin_tweet":
+				fmt.Fprintf(w, `{"pinned_tweets":[%s]}`, id)
+			default:
+				fmt.Fprintf(w, `{"id_str":%q,"full_text":"updated","favorited":%t,"user":{"id_str":"20","screen_name":"bob"}}`,
+					id, path == "favorites/create")
+			}
+		})
+	}
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	if _, err := scraper.Like("1"); !errors.Is(err, twitterscraper.ErrAuthRequired) {
+		t.Errorf("Expected ErrAuthRequired without cookies, got %v", err)
+	}
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	liked, err := scraper.Like("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if liked.ID != "1" || !liked.IsLiked || liked.Username != "bob" {
+		t.Errorf("Unexpected liked tweet %+v", liked)
+	}
+	retweet, err := scraper.Retweet("2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !retweet.IsRetweet || retweet.RetweetedStatus == nil || retweet.RetweetedStatus.ID != "2" || !retweet.RetweetedStatus.IsRetweeted {
+		t.Errorf("Unexpected retweet %+v", retweet)
+	}
+	pinned, err := scraper.PinTweet("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pinned.IsPin {
+		t.Errorf("Expected pinned tweet, got %+v", pinned)
+	}
+	for _, op := range []func() (*twitterscraper.Tweet, error){
+		func() (*twitterscraper.Tweet, error) { return scraper.Unlike("2") },
+		func() (*twitterscraper.Tweet, error) { return scraper.Unretweet("1") },
+		func() (*twitterscraper.Tweet, error) { return scraper.UnpinTweet("3") },
+	} {
+		if _, err := op(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// duplicate actions are rejected before request
+	for i, test := range []struct {
+		op  func() (*twitterscraper.Tweet, error)
+		err error
+	}{
+		{func() (*twitterscraper.Tweet, error) { return scraper.Like("2") }, twitterscraper.ErrAlreadyDone},
+		{func() (*twitterscraper.Tweet, error) { return scraper.Unlike("1") }, twitterscraper.ErrAlreadyDone},
+		{func() (*twitterscraper.Tweet, error) { return scraper.Retweet("1") }, twitterscraper.ErrAlreadyDone},
+		{func() (*twitterscraper.Tweet, error) { return scraper.Unretweet("2") }, twitterscraper.ErrAlreadyDone},
+		{func() (*twitterscraper.Tweet, error) { return scraper.PinTweet("3") }, twitterscraper.ErrAlreadyDone},
+		{func() (*twitterscraper.Tweet, error) { return scraper.UnpinTweet("1") }, twitterscraper.ErrAlreadyDone},
+		{func() (*twitterscraper.Tweet, error) { return scraper.PinTweet("2") }, twitterscraper.ErrNotOwned},
+	} {
+		if _, err := test.op(); !errors.Is(err, test.err) {
+			t.Errorf("Expected %v of action %d, got %v", test.err, i, err)
+		}
+	}
+
+	expected := []string{"favorites/create:1", "statuses/retweet/2:2", "account/pin_tweet:1",
+		"favorites/destroy:2", "statuses/unretweet/1:1", "account/unpin_tweet:3"}
+	if diff := cmp.Diff(expected, requests); diff != "" {
+		t.Error("Unexpected requests", diff)
+	}
+}
+
+func TestEngagementActionsSessionPool(t *testing.T) {
+	var cookies []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/timeline/conversation/1.json", func(w http.ResponseWriter, r *http.Request) {
+		cookies = append(cookies, r.Header.Get("Cookie"))
+		fmt.Fprint(w, `{"globalObjects":{"tweets":{"1":{"id_str":"1","full_text":"mine","user_id_str":"10"}},`+
+			`"users":{"10":{"id_str":"10","screen_name":"alice"}}},"timeline":{"instructions":[{"addEntries":{"entries":[`+
+			`{"entryId":"tweet-1","content":{"item":{"content":{"tweet":{"id":"1"}}}}}]}}]}}`)
+	})
+	mux.HandleFunc("/1.1/account/verify_credentials.json", func(w http.ResponseWriter, r *http.Request) {
+		cookies = append(cookies, r.Header.Get("Cookie"))
+		fmt.Fprint(w, `{"id_str":"10","screen_name":"alice"}`)
+	})
+	mux.HandleFunc("/i/api/1.1/favorites/create.json", func(w http.ResponseWriter, r *http.Request) {
+		cookies = append(cookies, r.Header.Get("Cookie"))
+		fmt.Fprint(w, `{"id_str":"1","full_text":"mine","favorited":true,"user":{"id_str":"10","screen_name":"alice"}}`)
+	})
+	mux.HandleFunc("/i/api/1.1/account/pin_tweet.json", func(w http.ResponseWriter, r *http.Request) {
+		cookies = append(cookies, r.Header.Get("Cookie"))
+		fmt.Fprint(w, `{"pinned_tweets":["1"]}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+	pool := twitterscraper.NewSessionPool(
+		twitterscraper.Session{Cookie: "auth_token=a", XCsrfToken: "a"},
+		twitterscraper.Session{Cookie: "auth_token=b", XCsrfToken: "b"},
+	)
+	scraper.WithSessionPool(pool)
+
+	// state check and action of each operation are made by the same session
+	for _, test := range []struct {
+		op      func() (*twitterscraper.Tweet, error)
+		cookies []string
+	}{
+		{func() (*twitterscraper.Tweet, error) { return scraper.Like("1") }, []string{"auth_token=a", "auth_token=a"}},
+		{func() (*twitterscraper.Tweet, error) { return scraper.Like("1") }, []string{"auth_token=b", "auth_token=b"}},
+		{func() (*twitterscraper.Tweet, error) { return scraper.PinTweet("1") },
+			[]string{"auth_token=a", "auth_token=a", "auth_token=a"}},
+	} {
+		cookies = nil
+		if _, err := test.op(); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.cookies, cookies); diff != "" {
+			t.Error("Unexpected session cookies", diff)
+		}
+	}
+	// requests of bound session are counted once each
+	for i, health := range pool.Health() {
+		if expected := []int{5, 2}[i]; health.Requests != expected {
+			t.Errorf("Expected %d requests of session %s, got %d", expected, health.Name, health.Requests)
+		}
+	}
+}
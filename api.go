@@ -1,94 +1,212 @@
 package twitterscraper
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"time"
 )
 
 const bearerToken string = "AAAAAAAAAAAAAAAAAAAAANRILgAAAAAAnNwIzUejRCOuH5E6I8xnZz4puTs%3D1Zv7ttfk8LF81IUq16cHjhLTvJu4FA33AGWWjCpTnA"
 
-// RequestAPI get JSON from frontend API and decodes it
+type bearerTokenKey struct{}
+
+type scraperCookiesKey struct{}
+
+type sessionKey struct{}
+
+// withBearerToken select bearer token for requests made with the returned context
+func withBearerToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, bearerTokenKey{}, token)
+}
+
+// withScraperCookies use Scraper cookie jar instead of session pool
+// for requests made with the returned context
+func withScraperCookies(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scraperCookiesKey{}, true)
+}
+
+// withSession pick one session of pool for all requests made with the returned context,
+// so state check and action of an operation are made by the same account
+func (s *Scraper) withSession(ctx context.Context) (context.Context, error) {
+	sessions := s.sessions()
+	if sessions == nil || ctx.Value(scraperCookiesKey{}) != nil || ctx.Value(sessionKey{}) != nil {
+		return ctx, nil
+	}
+	session, err := sessions.pick()
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, sessionKey{}, session), nil
+}
+
+// requestBearerToken of request context, or the Scraper default
+func (s *Scraper) requestBearerToken(ctx context.Context) string {
+	if token, ok := ctx.Value(bearerTokenKey{}).(string); ok {
+		return token
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.bearerToken
+}
+
+// RequestAPI get JSON from frontend API and decodes it,
+// request context cancels both the limiter wait and the HTTP call.
+// Transient failures of GET requests are retried according to the retry policy,
+// mutations are sent once so they are never applied twice.
 func (s *Scraper) RequestAPI(req *http.Request, target interface{}) error {
-	s.wg.Wait()
-	if s.delay > 0 {
-		defer func() {
-			s.wg.Add(1)
-			go func() {
-				time.Sleep(time.Second * time.Duration(s.delay))
-				s.wg.Done()
-			}()
-		}()
-	}
-
-	if !s.IsGuestToken() || s.guestCreatedAt.Before(time.Now().Add(-time.Hour*3)) {
-		err := s.GetGuestToken()
-		if err != nil {
-			return err
+	_, err := s.doAPI(req, target)
+	return err
+}
+
+// doAPI request with retries, returns the last response with closed body
+func (s *Scraper) doAPI(req *http.Request, target interface{}) (*http.Response, error) {
+	ctx := req.Context()
+	s.mu.RLock()
+	policy := s.retryPolicy
+	s.mu.RUnlock()
+	for attempt := 1; ; attempt++ {
+		resp, err := s.requestAPI(req, target)
+		if err == nil {
+			return resp, nil
+		}
+		if ctx.Err() != nil || attempt >= policy.MaxAttempts || !idempotent(req) || !retryable(resp) {
+			return resp, err
+		}
+
+		if resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusTooManyRequests) {
+			s.guestTokenPool().invalidate(req.Header.Get("X-Guest-Token"))
+		}
+		wait := policy.backoff(attempt)
+		if after := retryAfter(resp, s.isAuthenticated()); after > wait {
+			if after > policy.MaxWait {
+				return resp, err
+			}
+			wait = after
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return resp, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, err
+			}
+			req.Body = body
 		}
 	}
+}
+
+// requestAPI make a single attempt of API request,
+// response is returned with closed body for inspection of failed requests
+func (s *Scraper) requestAPI(req *http.Request, target interface{}) (resp *http.Response, err error) {
+	ctx := req.Context()
+	if err = s.waitLimiter(ctx, req.URL.String()); err != nil {
+		return nil, err
+	}
+
+	bearer := s.requestBearerToken(ctx)
+	pool := s.guestTokenPool()
+	guestToken, err := pool.acquire(ctx, bearer, func(ctx context.Context) (string, error) {
+		return s.activateGuestToken(ctx, bearer)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	req.Header.Set("Authorization", "Bearer "+s.bearerToken)
-	req.Header.Set("X-Guest-Token", s.guestToken)
+	req.Header.Set("Authorization", "Bearer "+bearer)
+	req.Header.Set("X-Guest-Token", guestToken)
+	// client adds jar cookies to request header, drop them of previous attempt
+	req.Header.Del("Cookie")
 
-	// use cookie
-	if len(s.cookie) > 0 && len(s.xCsrfToken) > 0 {
-		req.Header.Set("Cookie", s.cookie)
-		req.Header.Set("x-csrf-token", s.xCsrfToken)
+	// use cookie of session pool or scraper cookie jar
+	client := s.httpClient()
+	if sessions := s.sessions(); sessions != nil && ctx.Value(scraperCookiesKey{}) == nil {
+		session, ok := ctx.Value(sessionKey{}).(*pooledSession)
+		if !ok {
+			var pickErr error
+			if session, pickErr = sessions.pick(); pickErr != nil {
+				return nil, pickErr
+			}
+		}
+		sessions.use(session)
+		client = withoutJar(client)
+		req.Header.Set("Cookie", session.Cookie)
+		req.Header.Set("x-csrf-token", session.XCsrfToken)
+		defer func() { sessions.report(session, resp, err) }()
+	} else if xCsrfToken := s.csrfToken(req.URL); xCsrfToken != "" {
+		req.Header.Set("x-csrf-token", xCsrfToken)
 	}
 
-	resp, err := s.client.Do(req)
+	resp, err = client.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	// private profiles return forbidden, but also data
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusForbidden {
-		content, _ := ioutil.ReadAll(resp.Body)
-		return fmt.Errorf("response status %s: %s", resp.Status, content)
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
 	}
 
-	if resp.Header.Get("X-Rate-Limit-Remaining") == "0" {
-		s.guestToken = ""
+	// private profiles return forbidden, but also data
+	if resp.StatusCode != http.StatusOK && (resp.StatusCode != http.StatusForbidden || onlyErrors(content)) {
+		return resp, newAPIError(resp, content)
 	}
 
-	return json.NewDecoder(resp.Body).Decode(target)
+	pool.update(guestToken, resp)
+
+	return resp, json.Unmarshal(content, target)
 }
 
 // GetGuestToken from Twitter API
 func (s *Scraper) GetGuestToken() error {
-	req, err := http.NewRequest("POST", "https://api.twitter.com/1.1/guest/activate.json", nil)
+	return s.GetGuestTokenWithContext(context.Background())
+}
+
+// GetGuestTokenWithContext from Twitter API, the new token is added to guest token pool
+func (s *Scraper) GetGuestTokenWithContext(ctx context.Context) error {
+	bearer := s.requestBearerToken(ctx)
+	token, err := s.activateGuestToken(ctx, bearer)
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Authorization", "Bearer "+s.bearerToken)
+	s.guestTokenPool().add(bearer, token)
+	return nil
+}
 
-	resp, err := s.client.Do(req)
+// activateGuestToken of bearer token
+func (s *Scraper) activateGuestToken(ctx context.Context, bearer string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", s.endpointURL(EndpointGuestActivate), nil)
 	if err != nil {
-		return err
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+bearer)
+
+	resp, err := s.httpClient().Do(req)
+	if err != nil {
+		return "", err
 	}
 	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return "", err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("response status %s: %s", resp.Status, body)
+		return "", newAPIError(resp, body)
 	}
 
 	var jsn map[string]interface{}
 	if err := json.Unmarshal(body, &jsn); err != nil {
-		return err
+		return "", err
 	}
-	var ok bool
-	if s.guestToken, ok = jsn["guest_token"].(string); !ok {
-		return fmt.Errorf("guest_token not found")
+	token, ok := jsn["guest_token"].(string)
+	if !ok {
+		return "", fmt.Errorf("guest_token not found")
 	}
-	s.guestCreatedAt = time.Now()
 
-	return nil
+	return token, nil
 }
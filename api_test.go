@@ -1,11 +1,33 @@
 package twitterscraper_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	twitterscraper "github.com/JasonKhew96/twitter-scraper"
 )
 
+// guestActivations counter of test servers
+var guestActivations int32
+
+// newTestServer start local stand-in of Twitter API with guest token activation
+func newTestServer(mux *http.ServeMux) (*httptest.Server, *twitterscraper.Scraper) {
+	mux.HandleFunc("/1.1/guest/activate.json", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&guestActivations, 1)
+		fmt.Fprint(w, `{"guest_token":"1234567890"}`)
+	})
+	srv := httptest.NewServer(mux)
+	scraper := twitterscraper.New().WithBaseURLs(twitterscraper.BaseURLs{API: srv.URL, Web: srv.URL, Caps: srv.URL})
+	return srv, scraper
+}
+
 func TestGetGuestToken(t *testing.T) {
 	scraper := twitterscraper.New()
 	if err := scraper.GetGuestToken(); err != nil {
@@ -15,3 +37,103 @@ func TestGetGuestToken(t *testing.T) {
 		t.Error("Expected non-empty guestToken")
 	}
 }
+
+func TestWithBaseURLs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql/4S2ihIKfF3xhp-ENxvUAfQ/UserByScreenName", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Guest-Token") != "1234567890" {
+			t.Errorf("Expected guest token, got %q", r.Header.Get("X-Guest-Token"))
+		}
+		if v := r.URL.Query().Get("variables"); v != `{"screen_name":"Twitter","withHighlightedLabel":true}` {
+			t.Errorf("Unexpected variables: %s", v)
+		}
+		fmt.Fprint(w, `{"data":{"user":{"rest_id":"783214","legacy":{"screen_name":"Twitter","name":"Twitter"}}}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	profile, err := scraper.GetProfile("Twitter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.UserID != "783214" || profile.Username != "Twitter" {
+		t.Errorf("Unexpected profile: %+v", profile)
+	}
+}
+
+func TestWithEndpoint(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/moved/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") != "twitter" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"timeline":{"instructions":[{"addEntries":{"entries":[{"content":{"operation":{"cursor":{"value":"next","cursorType":"Bottom"}}}}]}}]}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	scraper.WithEndpoint(twitterscraper.EndpointSearch, "/moved/adaptive.json")
+	_, cursor, err := scraper.FetchSearchTweets("twitter", 20, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor != "next" {
+		t.Errorf("Expected cursor %q, got %q", "next", cursor)
+	}
+}
+
+func TestRequestAPIContext(t *testing.T) {
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	for tweet := range scraper.SearchTweets(ctx, "twitter", 20) {
+		if !errors.Is(tweet.Error, context.DeadlineExceeded) {
+			t.Errorf("Expected deadline exceeded, got %v", tweet.Error)
+		}
+	}
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/i/api/2/guide.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"timeline":{"instructions":[{},{"addEntries":{"entries":[{},{"content":{"timelineModule":{"items":[`+
+			`{"item":{"content":{"trend":{"name":"#Go"}}}}]}}}]}}]}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	atomic.StoreInt32(&guestActivations, 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := scraper.GetTrends(); err != nil {
+				t.Error(err)
+			}
+			scraper.WithReplies(true).SetSearchMode(twitterscraper.SearchLatest)
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&guestActivations); n != 1 {
+		t.Errorf("Expected single guest token activation, got %d", n)
+	}
+}
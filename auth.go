@@ -0,0 +1,219 @@
+package twitterscraper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// limit of login subtasks, guards against flows looping forever
+const maxLoginSubtasks = 20
+
+// LoginChallenge returns answer to login challenge, e.g. 2FA code or confirmation email.
+// Subtask is the onboarding subtask ID, hint is the text shown to user by Twitter.
+type LoginChallenge func(ctx context.Context, subtask string, hint string) (string, error)
+
+// LoginOptions of Login
+type LoginOptions struct {
+	// Email or phone of account, answers alternate identifier and email confirmation subtasks
+	Email string
+	// Challenge callback for 2FA code and other challenges, optional
+	Challenge LoginChallenge
+}
+
+type onboardingSubtask struct {
+	SubtaskID string `json:"subtask_id"`
+	EnterText struct {
+		HintText string `json:"hint_text"`
+		Header   struct {
+			PrimaryText struct {
+				Text string `json:"text"`
+			} `json:"primary_text"`
+		} `json:"header"`
+	} `json:"enter_text"`
+}
+
+type onboardingFlow struct {
+	FlowToken string              `json:"flow_token"`
+	Status    string              `json:"status"`
+	Subtasks  []onboardingSubtask `json:"subtasks"`
+}
+
+// Login to Twitter with username and password via web onboarding task flow,
+// session cookies are replaced by cookies received from login flow
+func (s *Scraper) Login(ctx context.Context, username, password string, opts *LoginOptions) error {
+	if opts == nil {
+		opts = &LoginOptions{}
+	}
+
+	s.clearCookies()
+	flow, err := s.onboardingTask(ctx, true, map[string]interface{}{
+		"input_flow_data": map[string]interface{}{
+			"flow_context": map[string]interface{}{
+				"debug_overrides": map[string]interface{}{},
+				"start_location": map[string]interface{}{
+					"location": "splash_screen",
+				},
+			},
+		},
+		"subtask_versions": map[string]interface{}{},
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < maxLoginSubtasks && len(flow.Subtasks) > 0; i++ {
+		subtask := flow.Subtasks[0]
+		input := map[string]interface{}{
+			"subtask_id": subtask.SubtaskID,
+		}
+		switch subtask.SubtaskID {
+		case "LoginSuccessSubtask":
+			return s.finishLogin()
+		case "DenyLoginSubtask":
+			return fmt.Errorf("login denied by Twitter: %w", ErrAuthRequired)
+		case "LoginJsInstrumentationSubtask":
+			input["js_instrumentation"] = map[string]interface{}{
+				"response": "{}",
+				"link":     "next_link",
+			}
+		case "LoginEnterUserIdentifierSSO":
+			input["settings_list"] = map[string]interface{}{
+				"setting_responses": []interface{}{
+					map[string]interface{}{
+						"key": "user_identifier",
+						"response_data": map[string]interface{}{
+							"text_data": map[string]interface{}{"result": username},
+						},
+					},
+				},
+				"link": "next_link",
+			}
+		case "LoginEnterPassword":
+			input["enter_password"] = map[string]interface{}{
+				"password": password,
+				"link":     "next_link",
+			}
+		case "AccountDuplicationCheck":
+			input["check_logged_in_account"] = map[string]interface{}{
+				"link": "AccountDuplicationCheck_false",
+			}
+		case "LoginEnterAlternateIdentifierSubtask", "LoginAcid", "LoginTwoFactorAuthChallenge":
+			answer, err := challengeAnswer(ctx, opts, subtask)
+			if err != nil {
+				return err
+			}
+			input["enter_text"] = map[string]interface{}{
+				"text": answer,
+				"link": "next_link",
+			}
+		default:
+			return fmt.Errorf("unsupported login subtask %s", subtask.SubtaskID)
+		}
+
+		flow, err = s.onboardingTask(ctx, false, map[string]interface{}{
+			"flow_token":     flow.FlowToken,
+			"subtask_inputs": []interface{}{input},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.finishLogin()
+}
+
+// challengeAnswer of login subtask: email answers alternate identifier request,
+// callback answers 2FA and, if set, email confirmation challenges
+func challengeAnswer(ctx context.Context, opts *LoginOptions, subtask onboardingSubtask) (string, error) {
+	useEmail := opts.Email != "" && (subtask.SubtaskID == "LoginEnterAlternateIdentifierSubtask" ||
+		(subtask.SubtaskID == "LoginAcid" && opts.Challenge == nil))
+	if useEmail {
+		return opts.Email, nil
+	}
+	if opts.Challenge == nil {
+		return "", fmt.Errorf("login subtask %s requires challenge answer: %w", subtask.SubtaskID, ErrAuthRequired)
+	}
+	hint := subtask.EnterText.Header.PrimaryText.Text
+	if hint == "" {
+		hint = subtask.EnterText.HintText
+	}
+	return opts.Challenge(ctx, subtask.SubtaskID, hint)
+}
+
+// finishLogin check session cookies received from login flow
+func (s *Scraper) finishLogin() error {
+	if !s.isAuthenticated() {
+		return fmt.Errorf("auth_token or ct0 cookie not received: %w", ErrAuthRequired)
+	}
+	return nil
+}
+
+// onboardingTask post step of login flow, response cookies are kept in Scraper cookie jar
+func (s *Scraper) onboardingTask(ctx context.Context, start bool, data interface{}) (*onboardingFlow, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withScraperCookies(ctx)
+	req, err := http.NewRequestWithContext(ctx, "POST", s.endpointURL(EndpointOnboardingTask), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if start {
+		q := req.URL.Query()
+		q.Add("flow_name", "login")
+		req.URL.RawQuery = q.Encode()
+	}
+
+	var flow onboardingFlow
+	if err := s.RequestAPI(req, &flow); err != nil {
+		return nil, err
+	}
+	return &flow, nil
+}
+
+// Logout of Twitter and clear session cookies, session pool is left untouched
+func (s *Scraper) Logout(ctx context.Context) error {
+	if err := s.checkCookieAuth(); err != nil {
+		return err
+	}
+	ctx = withScraperCookies(ctx)
+	req, err := http.NewRequestWithContext(ctx, "POST", s.endpointURL(EndpointLogout), nil)
+	if err != nil {
+		return err
+	}
+	var jsn interface{}
+	if err := s.RequestAPI(req, &jsn); err != nil {
+		return err
+	}
+	s.clearCookies()
+	return nil
+}
+
+// IsLoggedIn verify cookie authentication of Scraper with Twitter, session pool is not checked
+func (s *Scraper) IsLoggedIn(ctx context.Context) bool {
+	if s.checkCookieAuth() != nil {
+		return false
+	}
+	user, err := s.verifyCredentials(withScraperCookies(ctx))
+	return err == nil && user.ScreenName != ""
+}
+
+// verifyCredentials return user of cookie authentication
+func (s *Scraper) verifyCredentials(ctx context.Context) (*legacyUser, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", s.endpointURL(EndpointVerifyCredentials), nil)
+	if err != nil {
+		return nil, err
+	}
+	var user legacyUser
+	if err := s.RequestAPI(req, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
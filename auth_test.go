@@ -0,0 +1,134 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+// newOnboardingMux script login flow with 2FA challenge
+func newOnboardingMux(t *testing.T) *http.ServeMux {
+	steps := []string{
+		"LoginJsInstrumentationSubtask",
+		"LoginEnterUserIdentifierSSO",
+		"LoginEnterPassword",
+		"LoginTwoFactorAuthChallenge",
+		"LoginSuccessSubtask",
+	}
+	step := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/1.1/onboarding/task.json", func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			FlowToken     string `json:"flow_token"`
+			SubtaskInputs []struct {
+				SubtaskID     string `json:"subtask_id"`
+				EnterPassword struct {
+					Password string `json:"password"`
+				} `json:"enter_password"`
+				EnterText struct {
+					Text string `json:"text"`
+				} `json:"enter_text"`
+			} `json:"subtask_inputs"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Error(err)
+			return
+		}
+		if step == 0 {
+			if r.URL.Query().Get("flow_name") != "login" {
+				t.Errorf("Expected login flow, got %s", r.URL.RawQuery)
+			}
+			http.SetCookie(w, &http.Cookie{Name: "att", Value: "flow", Path: "/"})
+		} else {
+			if data.FlowToken != fmt.Sprintf("flow%d", step) {
+				t.Errorf("Unexpected flow token %s at step %d", data.FlowToken, step)
+			}
+			if !strings.Contains(r.Header.Get("Cookie"), "att=flow") {
+				t.Errorf("Expected att cookie, got %q", r.Header.Get("Cookie"))
+			}
+			input := data.SubtaskInputs[0]
+			if input.SubtaskID != steps[step-1] {
+				t.Errorf("Expected %s input, got %s", steps[step-1], input.SubtaskID)
+			}
+			switch input.SubtaskID {
+			case "LoginEnterPassword":
+				if input.EnterPassword.Password != "secret" {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"errors":[{"code":399,"message":"Wrong password!"}]}`)
+					return
+				}
+			case "LoginTwoFactorAuthChallenge":
+				if input.EnterText.Text != "123456" {
+					t.Errorf("Unexpected 2FA code %s", input.EnterText.Text)
+				}
+				http.SetCookie(w, &http.Cookie{Name: "auth_token", Value: "token", Path: "/"})
+				http.SetCookie(w, &http.Cookie{Name: "ct0", Value: "csrf", Path: "/"})
+			}
+		}
+		step++
+		fmt.Fprintf(w, `{"flow_token":"flow%d","status":"success","subtasks":[{"subtask_id":%q,"enter_text":{"header":{"primary_text":{"text":"Enter code"}}}}]}`, step, steps[step-1])
+	})
+	mux.HandleFunc("/1.1/account/verify_credentials.json", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Cookie"), "auth_token=token") || r.Header.Get("x-csrf-token") != "csrf" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"errors":[{"code":32,"message":"Could not authenticate you."}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"id_str":"1","screen_name":"scraper"}`)
+	})
+	mux.HandleFunc("/1.1/account/logout.json", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Cookie"), "auth_token=token") {
+			t.Errorf("Expected logout of scraper session, got cookie %q", r.Header.Get("Cookie"))
+		}
+		fmt.Fprint(w, `{"status":"ok"}`)
+	})
+	return mux
+}
+
+func TestLogin(t *testing.T) {
+	srv, scraper := newTestServer(newOnboardingMux(t))
+	defer srv.Close()
+
+	ctx := context.Background()
+	if scraper.IsLoggedIn(ctx) {
+		t.Error("Expected not logged in before login")
+	}
+	err := scraper.Login(ctx, "scraper", "secret", &twitterscraper.LoginOptions{
+		Challenge: func(ctx context.Context, subtask string, hint string) (string, error) {
+			if subtask != "LoginTwoFactorAuthChallenge" || hint != "Enter code" {
+				t.Errorf("Unexpected challenge %s: %s", subtask, hint)
+			}
+			return "123456", nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// pooled accounts are neither verified nor logged out
+	scraper.WithSessionPool(twitterscraper.NewSessionPool(twitterscraper.Session{Cookie: "auth_token=pooled", XCsrfToken: "pooled"}))
+	if !scraper.IsLoggedIn(ctx) {
+		t.Error("Expected logged in")
+	}
+	if err := scraper.Logout(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if scraper.IsLoggedIn(ctx) {
+		t.Error("Expected logged out")
+	}
+}
+
+func TestLoginErrors(t *testing.T) {
+	srv, scraper := newTestServer(newOnboardingMux(t))
+	defer srv.Close()
+
+	err := scraper.Login(context.Background(), "scraper", "wrong", nil)
+	if !errors.Is(err, twitterscraper.ErrAuthRequired) {
+		t.Errorf("Expected ErrAuthRequired on wrong password, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package twitterscraper
+
+import (
+	"context"
+	"fmt"
+)
+
+type bookmarksTimeline struct {
+	Data struct {
+		BookmarkTimelineV2 struct {
+			Timeline graphQLTimeline `json:"timeline"`
+		} `json:"bookmark_timeline_v2"`
+	} `json:"data"`
+	Errors []APIErrorDetail `json:"errors"`
+}
+
+type bookmarkResult struct {
+	Data struct {
+		TweetBookmarkPut    string `json:"tweet_bookmark_put"`
+		TweetBookmarkDelete string `json:"tweet_bookmark_delete"`
+	} `json:"data"`
+	Errors []APIErrorDetail `json:"errors"`
+}
+
+// GetBookmarks returns channel with bookmarked tweets of authenticated user.
+func (s *Scraper) GetBookmarks(ctx context.Context, maxTweetsNbr int) <-chan *TweetResult {
+	return getTweetTimeline(ctx, "", maxTweetsNbr, s.FetchBookmarksWithContext)
+}
+
+// FetchBookmarks gets bookmarked tweets of authenticated user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchBookmarks(_ string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.FetchBookmarksWithContext(context.Background(), "", maxTweetsNbr, cursor)
+}
+
+// FetchBookmarksWithContext gets bookmarked tweets of authenticated user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchBookmarksWithContext(ctx context.Context, _ string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, "", err
+	}
+
+	if maxTweetsNbr > 20 {
+		maxTweetsNbr = 20
+	}
+
+	variables := map[string]interface{}{
+		"count":                  maxTweetsNbr,
+		"includePromotedContent": false,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+	req, err := s.newGraphQLRequest(ctx, EndpointBookmarks, variables)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var jsn bookmarksTimeline
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, "", err
+	}
+
+	timeline := &jsn.Data.BookmarkTimelineV2.Timeline
+	if len(timeline.Instructions) == 0 {
+		if err := graphQLError(jsn.Errors); err != nil {
+			return nil, "", err
+		}
+	}
+
+	tweets, nextCursor := timeline.toTimeline().parseTweets()
+	return tweets, nextCursor, nil
+}
+
+// AddBookmark of tweet, requires cookie authentication
+func (s *Scraper) AddBookmark(id string) error {
+	return s.AddBookmarkWithContext(context.Background(), id)
+}
+
+// AddBookmarkWithContext of tweet, requires cookie authentication
+func (s *Scraper) AddBookmarkWithContext(ctx context.Context, id string) error {
+	jsn, err := s.bookmarkRequest(ctx, EndpointCreateBookmark, id)
+	if err != nil {
+		return err
+	}
+	if jsn.Data.TweetBookmarkPut != "Done" {
+		return fmt.Errorf("bookmark of tweet %s not added", id)
+	}
+	return nil
+}
+
+// RemoveBookmark of tweet, requires cookie authentication
+func (s *Scraper) RemoveBookmark(id string) error {
+	return s.RemoveBookmarkWithContext(context.Background(), id)
+}
+
+// RemoveBookmarkWithContext of tweet, requires cookie authentication
+func (s *Scraper) RemoveBookmarkWithContext(ctx context.Context, id string) error {
+	jsn, err := s.bookmarkRequest(ctx, EndpointDeleteBookmark, id)
+	if err != nil {
+		return err
+	}
+	if jsn.Data.TweetBookmarkDelete != "Done" {
+		return fmt.Errorf("bookmark of tweet %s not removed", id)
+	}
+	return nil
+}
+
+func (s *Scraper) bookmarkRequest(ctx context.Context, endpoint Endpoint, id string) (*bookmarkResult, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.newGraphQLMutation(ctx, endpoint, map[string]interface{}{"tweet_id": id}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsn bookmarkResult
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := graphQLError(jsn.Errors); err != nil {
+		return nil, err
+	}
+	return &jsn, nil
+}
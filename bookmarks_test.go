@@ -0,0 +1,98 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+func TestGetBookmarks(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/graphql/3OjEFzT2VjX-X7w4KYBJRg/Bookmarks", func(w http.ResponseWriter, r *http.Request) {
+		var instruction string
+		if strings.Contains(r.URL.Query().Get("variables"), `"cursor":"page2"`) {
+			instruction = tweetEntries("page3")
+		} else {
+			instruction = tweetEntries("page2", "800", "801")
+		}
+		fmt.Fprintf(w, `{"data":{"bookmark_timeline_v2":{"timeline":{"instructions":[%s]}}}}`, instruction)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	for tweet := range scraper.GetBookmarks(context.Background(), 10) {
+		if !errors.Is(tweet.Error, twitterscraper.ErrAuthRequired) {
+			t.Errorf("Expected ErrAuthRequired without cookies, got %v", tweet.Error)
+		}
+	}
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	var ids []string
+	for tweet := range scraper.GetBookmarks(context.Background(), 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		ids = append(ids, tweet.ID)
+	}
+	if strings.Join(ids, ",") != "800,801" {
+		t.Errorf("Expected bookmarks 800,801, got %v", ids)
+	}
+}
+
+func TestBookmarkActions(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	for name, result := range map[string]string{
+		"aoDbu3RHznuiSkQ9aNM67Q/CreateBookmark": "tweet_bookmark_put",
+		"Wlmlj2-xzyS1GN3a6cj-mQ/DeleteBookmark": "tweet_bookmark_delete",
+	} {
+		result := result
+		mux.HandleFunc("/i/api/graphql/"+name, func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Variables struct {
+					TweetID string `json:"tweet_id"`
+				} `json:"variables"`
+				QueryID string `json:"queryId"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			if r.Method != "POST" || r.Header.Get("x-csrf-token") != "csrf" {
+				t.Errorf("Expected authenticated POST, got %s with csrf %q", r.Method, r.Header.Get("x-csrf-token"))
+			}
+			requests = append(requests, body.QueryID+":"+body.Variables.TweetID)
+			if body.Variables.TweetID == "404" {
+				fmt.Fprint(w, `{"errors":[{"message":"Tweet not found","code":144}]}`)
+				return
+			}
+			fmt.Fprintf(w, `{"data":{%q:"Done"}}`, result)
+		})
+	}
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	if err := scraper.AddBookmark("800"); !errors.Is(err, twitterscraper.ErrAuthRequired) {
+		t.Errorf("Expected ErrAuthRequired without cookies, got %v", err)
+	}
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	if err := scraper.AddBookmark("800"); err != nil {
+		t.Fatal(err)
+	}
+	if err := scraper.RemoveBookmark("800"); err != nil {
+		t.Fatal(err)
+	}
+	var apiErr *twitterscraper.APIError
+	if err := scraper.AddBookmark("404"); !errors.As(err, &apiErr) {
+		t.Errorf("Expected APIError, got %v", err)
+	}
+	if strings.Join(requests, ",") != "aoDbu3RHznuiSkQ9aNM67Q:800,Wlmlj2-xzyS1GN3a6cj-mQ:800,aoDbu3RHznuiSkQ9aNM67Q:404" {
+		t.Errorf("Unexpected requests %v", requests)
+	}
+}
@@ -0,0 +1,159 @@
+package twitterscraper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ConversationNode of conversation tree
+type ConversationNode struct {
+	Tweet    *Tweet
+	Parent   *ConversationNode
+	Children []*ConversationNode
+	// Depth of node, root tweet has depth 0
+	Depth int
+}
+
+// Walk call fn for node and its descendants in depth-first order
+func (node *ConversationNode) Walk(fn func(*ConversationNode)) {
+	fn(node)
+	for _, child := range node.Children {
+		child.Walk(fn)
+	}
+}
+
+// Find node of tweet in tree, nil if not found
+func (node *ConversationNode) Find(id string) *ConversationNode {
+	if node.Tweet.ID == id {
+		return node
+	}
+	for _, child := range node.Children {
+		if found := child.Find(id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// GetConversation returns tree of conversation containing tweet, rooted at the first tweet of conversation.
+// All pages of conversation are loaded including "show more replies",
+// replies to deleted or unavailable tweets are left out.
+func (s *Scraper) GetConversation(ctx context.Context, id string) (*ConversationNode, error) {
+	var all timeline
+	all.GlobalObjects.Tweets = make(map[string]legacyTweet)
+	all.GlobalObjects.Users = make(map[string]legacyUser)
+
+	cursors := []string{""}
+	visited := map[string]bool{"": true}
+	for len(cursors) > 0 {
+		cursor := cursors[0]
+		cursors = cursors[1:]
+
+		timeline, err := s.fetchConversation(ctx, id, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for tweetID, tweet := range timeline.GlobalObjects.Tweets {
+			all.GlobalObjects.Tweets[tweetID] = tweet
+		}
+		for userID, user := range timeline.GlobalObjects.Users {
+			all.GlobalObjects.Users[userID] = user
+		}
+		for _, next := range timeline.conversationCursors() {
+			if !visited[next] {
+				visited[next] = true
+				cursors = append(cursors, next)
+			}
+		}
+	}
+
+	// parse each tweet once without its chain of replied tweets, nodes are linked afterwards
+	replyTo := make(map[string]string, len(all.GlobalObjects.Tweets))
+	for tweetID, tweet := range all.GlobalObjects.Tweets {
+		replyTo[tweetID] = tweet.InReplyToStatusIDStr
+		tweet.InReplyToStatusIDStr = ""
+		all.GlobalObjects.Tweets[tweetID] = tweet
+	}
+	nodes := make(map[string]*ConversationNode)
+	for tweetID := range all.GlobalObjects.Tweets {
+		if tweet := all.parseTweet(tweetID); tweet != nil {
+			nodes[tweetID] = &ConversationNode{Tweet: tweet}
+		}
+	}
+	focal, ok := nodes[id]
+	if !ok {
+		return nil, fmt.Errorf("tweet with ID %s not found: %w", id, ErrNotFound)
+	}
+
+	for tweetID, node := range nodes {
+		if replyTo[tweetID] == "" {
+			continue
+		}
+		node.Tweet.IsReply = true
+		if parent, ok := nodes[replyTo[tweetID]]; ok {
+			node.Tweet.InReplyToStatus = parent.Tweet
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	root := focal
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	root.Walk(func(node *ConversationNode) {
+		if node.Parent != nil {
+			node.Depth = node.Parent.Depth + 1
+		}
+		sort.Slice(node.Children, func(i, j int) bool {
+			a, b := node.Children[i].Tweet, node.Children[j].Tweet
+			if a.Timestamp != b.Timestamp {
+				return a.Timestamp < b.Timestamp
+			}
+			return a.ID < b.ID
+		})
+	})
+	return root, nil
+}
+
+// fetchConversation page of tweet conversation
+func (s *Scraper) fetchConversation(ctx context.Context, id string, cursor string) (*timeline, error) {
+	req, err := s.newRequest(ctx, "GET", s.endpointURL(EndpointConversation, id))
+	if err != nil {
+		return nil, err
+	}
+	if cursor != "" {
+		q := req.URL.Query()
+		q.Add("cursor", cursor)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	var timeline timeline
+	err = s.RequestAPI(req, &timeline)
+	if err != nil {
+		return nil, err
+	}
+	return &timeline, nil
+}
+
+// conversationCursors of next pages and "show more replies" of threads
+func (timeline *timeline) conversationCursors() []string {
+	var cursors []string
+	add := func(value, cursorType string) {
+		if value != "" && cursorType != "Top" {
+			cursors = append(cursors, value)
+		}
+	}
+	for _, instruction := range timeline.Timeline.Instructions {
+		for _, entry := range instruction.AddEntries.Entries {
+			add(entry.Content.Operation.Cursor.Value, entry.Content.Operation.Cursor.CursorType)
+			for _, item := range entry.Content.TimelineModule.Items {
+				add(item.Item.Content.TimelineCursor.Value, item.Item.Content.TimelineCursor.CursorType)
+			}
+		}
+		cursor := instruction.ReplaceEntry.Entry.Content.Operation.Cursor
+		add(cursor.Value, cursor.CursorType)
+	}
+	return cursors
+}
@@ -0,0 +1,152 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+// conversationTweet of globalObjects, replying to parent if set
+type conversationTweet struct {
+	id, parent, user, text string
+}
+
+// conversationPage of conversation timeline with tweets, thread module with cursor and bottom cursor
+func conversationPage(tweets []conversationTweet, showMore, bottom string) string {
+	var objects, entries []string
+	for i, tweet := range tweets {
+		objects = append(objects, fmt.Sprintf(`%q:{"id_str":%[1]q,"full_text":%q,"user_id_str":%q,"in_reply_to_status_id_str":%q,`+
+			`"conversation_id_str":"1","created_at":"Mon Jan 02 15:04:%02d +0000 2006"}`, tweet.id, tweet.text, tweet.user, tweet.parent, i))
+		entries = append(entries, fmt.Sprintf(`{"entryId":"tweet-%s","content":{"item":{"content":{"tweet":{"id":%[1]q}}}}}`, tweet.id))
+	}
+	if showMore != "" {
+		entries = append(entries, fmt.Sprintf(`{"entryId":"conversationThread-x","content":{"timelineModule":{"items":[`+
+			`{"entryId":"cursor-showmore","item":{"content":{"timelineCursor":{"value":%q,"cursorType":"ShowMore"}}}}]}}}`, showMore))
+	}
+	if bottom != "" {
+		entries = append(entries, fmt.Sprintf(`{"entryId":"cursor-bottom","content":{"operation":{"cursor":{"value":%q,"cursorType":"Bottom"}}}}`, bottom))
+	}
+	entries = append(entries, `{"entryId":"cursor-top","content":{"operation":{"cursor":{"value":"top","cursorType":"Top"}}}}`)
+	return `{"globalObjects":{"tweets":{` + strings.Join(objects, ",") + `},"users":{` +
+		`"10":{"id_str":"10","screen_name":"alice"},"20":{"id_str":"20","screen_name":"bob"}}},` +
+		`"timeline":{"instructions":[{"addEntries":{"entries":[` + strings.Join(entries, ",") + `]}}]}}`
+}
+
+// handleConversation serve conversation pages by cursor
+func handleConversation(t *testing.T, mux *http.ServeMux, id string, pages map[string]string) {
+	mux.HandleFunc("/i/api/2/timeline/conversation/"+id+".json", func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Query().Get("cursor")]
+		if !ok {
+			t.Errorf("Unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+		fmt.Fprint(w, page)
+	})
+}
+
+func TestGetConversation(t *testing.T) {
+	mux := http.NewServeMux()
+	handleConversation(t, mux, "2", map[string]string{
+		"": conversationPage([]conversationTweet{
+			{id: "1", user: "10", text: "root"},
+			{id: "2", parent: "1", user: "10", text: "focal"},
+			{id: "3", parent: "1", user: "20", text: "reply"},
+		}, "more", "next"),
+		"more": conversationPage([]conversationTweet{
+			{id: "4", parent: "2", user: "20", text: "hidden reply"},
+		}, "", ""),
+		"next": conversationPage([]conversationTweet{
+			{id: "5", parent: "3", user: "10", text: "late reply"},
+			{id: "6", parent: "404", user: "10", text: "orphan"},
+		}, "", "next"),
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	root, err := scraper.GetConversation(context.Background(), "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tree []string
+	root.Walk(func(node *twitterscraper.ConversationNode) {
+		tree = append(tree, fmt.Sprintf("%s@%d", node.Tweet.ID, node.Depth))
+	})
+	if strings.Join(tree, ",") != "1@0,2@1,4@2,3@1,5@2" {
+		t.Errorf("Unexpected conversation tree %v", tree)
+	}
+	if node := root.Find("5"); node == nil || node.Parent.Tweet.Username != "bob" {
+		t.Errorf("Expected reply 5 to bob, got %+v", node)
+	}
+	if node := root.Find("5"); !node.Tweet.IsReply || node.Tweet.InReplyToStatus != node.Parent.Tweet {
+		t.Errorf("Expected InReplyToStatus of parent node, got %+v", node.Tweet.InReplyToStatus)
+	}
+
+	if _, err := scraper.GetConversation(context.Background(), "404"); !errors.Is(err, twitterscraper.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestGetConversationDeepThread(t *testing.T) {
+	const depth = 1000
+	tweets := make([]conversationTweet, depth)
+	for i := range tweets {
+		tweets[i] = conversationTweet{id: fmt.Sprint(i + 1), user: "10", text: "self reply"}
+		if i > 0 {
+			tweets[i].parent = fmt.Sprint(i)
+		}
+	}
+	mux := http.NewServeMux()
+	handleConversation(t, mux, "1", map[string]string{"": conversationPage(tweets, "", "")})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	root, err := scraper.GetConversation(context.Background(), "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf := root.Find(fmt.Sprint(depth))
+	if leaf == nil || leaf.Depth != depth-1 {
+		t.Fatalf("Expected leaf at depth %d, got %+v", depth-1, leaf)
+	}
+	// replied tweets are shared with parent nodes instead of parsed per tweet
+	for node := leaf; node.Parent != nil; node = node.Parent {
+		if node.Tweet.InReplyToStatus != node.Parent.Tweet {
+			t.Fatalf("Expected InReplyToStatus of tweet %s linked to parent node", node.Tweet.ID)
+		}
+	}
+}
+
+func TestUnrollThread(t *testing.T) {
+	mux := http.NewServeMux()
+	handleConversation(t, mux, "4", map[string]string{
+		"": conversationPage([]conversationTweet{
+			{id: "1", user: "10", text: "1/ start https://example.com"},
+			{id: "2", parent: "1", user: "20", text: "nice"},
+			{id: "3", parent: "1", user: "10", text: "2/ draft branch"},
+			{id: "4", parent: "1", user: "10", text: "2/ middle"},
+			{id: "5", parent: "4", user: "20", text: "agreed"},
+			{id: "6", parent: "4", user: "10", text: "3/ end"},
+		}, "", ""),
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	thread, err := scraper.UnrollThread(context.Background(), "4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, tweet := range thread.Tweets {
+		ids = append(ids, tweet.ID)
+	}
+	if strings.Join(ids, ",") != "1,4,6" {
+		t.Errorf("Expected thread 1,4,6, got %v", ids)
+	}
+	if thread.Text != "1/ start https://example.com\n\n2/ middle\n\n3/ end" {
+		t.Errorf("Unexpected thread text %q", thread.Text)
+	}
+}
@@ -0,0 +1,307 @@
+package twitterscraper
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cookieJar keeps cookies of Scraper session and records them for SaveSession
+type cookieJar struct {
+	mu      sync.Mutex
+	jar     *cookiejar.Jar
+	records map[string]savedCookie
+}
+
+// savedCookie in session file
+type savedCookie struct {
+	URL      string    `json:"url"`
+	Name     string    `json:"name"`
+	Value    string    `json:"value"`
+	Domain   string    `json:"domain,omitempty"`
+	Path     string    `json:"path,omitempty"`
+	Expires  time.Time `json:"expires,omitempty"`
+	Secure   bool      `json:"secure,omitempty"`
+	HttpOnly bool      `json:"http_only,omitempty"`
+}
+
+func newCookieJar() *cookieJar {
+	jar, _ := cookiejar.New(nil)
+	return &cookieJar{
+		jar:     jar,
+		records: make(map[string]savedCookie),
+	}
+}
+
+// SetCookies implements http.CookieJar
+func (j *cookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.jar.SetCookies(u, cookies)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := time.Now()
+	for _, cookie := range cookies {
+		domain := strings.TrimPrefix(cookie.Domain, ".")
+		if domain == "" {
+			domain = u.Hostname()
+		}
+		path := cookie.Path
+		if path == "" {
+			path = "/"
+		}
+		key := domain + ";" + path + ";" + cookie.Name
+		expires := cookie.Expires
+		if cookie.MaxAge > 0 {
+			expires = now.Add(time.Duration(cookie.MaxAge) * time.Second)
+		}
+		if cookie.MaxAge < 0 || (!expires.IsZero() && expires.Before(now)) {
+			delete(j.records, key)
+			continue
+		}
+		j.records[key] = savedCookie{
+			URL:      u.Scheme + "://" + u.Host,
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Domain:   cookie.Domain,
+			Path:     cookie.Path,
+			Expires:  expires,
+			Secure:   cookie.Secure,
+			HttpOnly: cookie.HttpOnly,
+		}
+	}
+}
+
+// Cookies implements http.CookieJar
+func (j *cookieJar) Cookies(u *url.URL) []*http.Cookie {
+	return j.jar.Cookies(u)
+}
+
+// saved cookies which are not expired
+func (j *cookieJar) saved() []savedCookie {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := time.Now()
+	cookies := make([]savedCookie, 0, len(j.records))
+	for _, cookie := range j.records {
+		if cookie.Expires.IsZero() || cookie.Expires.After(now) {
+			cookies = append(cookies, cookie)
+		}
+	}
+	return cookies
+}
+
+// load saved cookie into jar
+func (j *cookieJar) load(cookie savedCookie) error {
+	u, err := url.Parse(cookie.URL)
+	if err != nil {
+		return err
+	}
+	j.SetCookies(u, []*http.Cookie{{
+		Name:     cookie.Name,
+		Value:    cookie.Value,
+		Domain:   cookie.Domain,
+		Path:     cookie.Path,
+		Expires:  cookie.Expires,
+		Secure:   cookie.Secure,
+		HttpOnly: cookie.HttpOnly,
+	}})
+	return nil
+}
+
+// jar of Scraper client
+func (s *Scraper) jar() *cookieJar {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cookieJar
+}
+
+// clearCookies start a new empty cookie session
+func (s *Scraper) clearCookies() {
+	jar := newCookieJar()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cookieJar = jar
+	client := *s.client
+	client.Jar = jar
+	s.client = &client
+	s.xCsrfToken = ""
+}
+
+// withoutJar copy of client, for requests with session pool cookies
+func withoutJar(client *http.Client) *http.Client {
+	c := *client
+	c.Jar = nil
+	return &c
+}
+
+// sessionCookies of API, Web and Caps hosts
+func (s *Scraper) sessionCookies() map[string]string {
+	s.mu.RLock()
+	urls := []string{s.baseURLs.Web, s.baseURLs.API, s.baseURLs.Caps}
+	s.mu.RUnlock()
+
+	cookies := make(map[string]string)
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			continue
+		}
+		for _, cookie := range s.jar().Cookies(u) {
+			if _, ok := cookies[cookie.Name]; !ok {
+				cookies[cookie.Name] = cookie.Value
+			}
+		}
+	}
+	return cookies
+}
+
+// copyCookies of session to hosts without cookies, so they follow hosts changed by WithBaseURLs
+func (s *Scraper) copyCookies(cookies map[string]string, urls []string) {
+	if len(cookies) == 0 {
+		return
+	}
+	jar := s.jar()
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil || len(jar.Cookies(u)) > 0 {
+			continue
+		}
+		copied := make([]*http.Cookie, 0, len(cookies))
+		for name, value := range cookies {
+			copied = append(copied, &http.Cookie{Name: name, Value: value, Path: "/"})
+		}
+		jar.SetCookies(u, copied)
+	}
+}
+
+// csrfToken of request: token set by WithXCsrfToken, or ct0 cookie of session
+func (s *Scraper) csrfToken(u *url.URL) string {
+	s.mu.RLock()
+	token := s.xCsrfToken
+	s.mu.RUnlock()
+	if token != "" {
+		return token
+	}
+	for _, cookie := range s.jar().Cookies(u) {
+		if cookie.Name == "ct0" {
+			return cookie.Value
+		}
+	}
+	return s.sessionCookies()["ct0"]
+}
+
+// SaveSession write cookies of session as JSON
+func (s *Scraper) SaveSession(w io.Writer) error {
+	return json.NewEncoder(w).Encode(struct {
+		Cookies []savedCookie `json:"cookies"`
+	}{s.jar().saved()})
+}
+
+// LoadSession read cookies of session written by SaveSession
+func (s *Scraper) LoadSession(r io.Reader) error {
+	var session struct {
+		Cookies []savedCookie `json:"cookies"`
+	}
+	if err := json.NewDecoder(r).Decode(&session); err != nil {
+		return err
+	}
+	jar := s.jar()
+	for _, cookie := range session.Cookies {
+		if err := jar.load(cookie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportCookiesTxt read cookies in Netscape `cookies.txt` format
+func (s *Scraper) ImportCookiesTxt(r io.Reader) error {
+	jar := s.jar()
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		httpOnly := strings.HasPrefix(text, "#HttpOnly_")
+		text = strings.TrimPrefix(text, "#HttpOnly_")
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		// domain, include subdomains, path, secure, expiration, name, value
+		fields := strings.Split(text, "\t")
+		if len(fields) != 7 {
+			return fmt.Errorf("cookies.txt line %d: expected 7 fields, got %d", line, len(fields))
+		}
+		cookie := savedCookie{
+			Name:     fields[5],
+			Value:    fields[6],
+			Path:     fields[2],
+			Secure:   strings.EqualFold(fields[3], "TRUE"),
+			HttpOnly: httpOnly,
+		}
+		if strings.EqualFold(fields[1], "TRUE") {
+			cookie.Domain = fields[0]
+		}
+		if expires, err := strconv.ParseInt(fields[4], 10, 64); err == nil && expires > 0 {
+			cookie.Expires = time.Unix(expires, 0)
+		}
+		cookie.URL = cookieURL(fields[0], cookie.Secure)
+		if err := jar.load(cookie); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// ImportCookiesJSON read cookies exported by browser extensions (EditThisCookie, Cookie-Editor)
+func (s *Scraper) ImportCookiesJSON(r io.Reader) error {
+	var cookies []struct {
+		Domain         string  `json:"domain"`
+		Name           string  `json:"name"`
+		Value          string  `json:"value"`
+		Path           string  `json:"path"`
+		ExpirationDate float64 `json:"expirationDate"`
+		Secure         bool    `json:"secure"`
+		HttpOnly       bool    `json:"httpOnly"`
+		HostOnly       bool    `json:"hostOnly"`
+	}
+	if err := json.NewDecoder(r).Decode(&cookies); err != nil {
+		return err
+	}
+	jar := s.jar()
+	for _, c := range cookies {
+		cookie := savedCookie{
+			URL:      cookieURL(c.Domain, c.Secure),
+			Name:     c.Name,
+			Value:    c.Value,
+			Path:     c.Path,
+			Secure:   c.Secure,
+			HttpOnly: c.HttpOnly,
+		}
+		if !c.HostOnly {
+			cookie.Domain = c.Domain
+		}
+		if c.ExpirationDate > 0 {
+			cookie.Expires = time.Unix(int64(c.ExpirationDate), 0)
+		}
+		if err := jar.load(cookie); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cookieURL of cookie domain
+func cookieURL(domain string, secure bool) string {
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
+	return scheme + "://" + strings.TrimPrefix(domain, ".")
+}
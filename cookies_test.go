@@ -0,0 +1,136 @@
+package twitterscraper_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+// newCookieMux verify auth_token and ct0 cookies, rotating ct0 on first request
+func newCookieMux(t *testing.T, csrf *[]string) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/1.1/account/verify_credentials.json", func(w http.ResponseWriter, r *http.Request) {
+		token, err := r.Cookie("auth_token")
+		if err != nil || token.Value != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"errors":[{"code":32,"message":"Could not authenticate you."}]}`)
+			return
+		}
+		ct0, err := r.Cookie("ct0")
+		if err != nil || r.Header.Get("x-csrf-token") != ct0.Value {
+			t.Errorf("Expected x-csrf-token of ct0 cookie, got %q", r.Header.Get("x-csrf-token"))
+		}
+		*csrf = append(*csrf, r.Header.Get("x-csrf-token"))
+		if len(*csrf) == 1 {
+			http.SetCookie(w, &http.Cookie{Name: "ct0", Value: "rotated", Path: "/"})
+		}
+		fmt.Fprint(w, `{"id_str":"1","screen_name":"scraper"}`)
+	})
+	return mux
+}
+
+func TestWithCookie(t *testing.T) {
+	var csrf []string
+	srv, scraper := newTestServer(newCookieMux(t, &csrf))
+	defer srv.Close()
+
+	ctx := context.Background()
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+	if !scraper.IsLoggedIn(ctx) || !scraper.IsLoggedIn(ctx) {
+		t.Fatal("Expected logged in")
+	}
+	if strings.Join(csrf, ",") != "csrf,rotated" {
+		t.Errorf("Expected rotated ct0 in second request, got %v", csrf)
+	}
+
+	scraper.WithCookie("")
+	if scraper.IsLoggedIn(ctx) {
+		t.Error("Expected logged out with empty cookie")
+	}
+}
+
+func TestWithCookieBeforeBaseURLs(t *testing.T) {
+	var csrf []string
+	srv, _ := newTestServer(newCookieMux(t, &csrf))
+	defer srv.Close()
+
+	// cookies follow hosts set after WithCookie
+	scraper := twitterscraper.New().
+		WithCookie("auth_token=token; ct0=csrf").
+		WithBaseURLs(twitterscraper.BaseURLs{API: srv.URL, Web: srv.URL, Caps: srv.URL})
+	if !scraper.IsLoggedIn(context.Background()) {
+		t.Fatal("Expected logged in with cookie set before base URLs")
+	}
+	if strings.Join(csrf, ",") != "csrf" {
+		t.Errorf("Expected csrf of copied ct0 cookie, got %v", csrf)
+	}
+}
+
+func TestSaveSession(t *testing.T) {
+	var csrf []string
+	srv, scraper := newTestServer(newCookieMux(t, &csrf))
+	defer srv.Close()
+
+	ctx := context.Background()
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+	if !scraper.IsLoggedIn(ctx) {
+		t.Fatal("Expected logged in")
+	}
+	var session bytes.Buffer
+	if err := scraper.SaveSession(&session); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := twitterscraper.New().WithBaseURLs(twitterscraper.BaseURLs{API: srv.URL, Web: srv.URL})
+	if err := restored.LoadSession(&session); err != nil {
+		t.Fatal(err)
+	}
+	if !restored.IsLoggedIn(ctx) {
+		t.Fatal("Expected logged in with loaded session")
+	}
+	if csrf[len(csrf)-1] != "rotated" {
+		t.Errorf("Expected rotated ct0 in loaded session, got %v", csrf)
+	}
+}
+
+func TestImportCookies(t *testing.T) {
+	var csrf []string
+	srv, _ := newTestServer(newCookieMux(t, &csrf))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+	host := u.Hostname()
+
+	ctx := context.Background()
+	txt := "# Netscape HTTP Cookie File\n\n" +
+		"#HttpOnly_" + host + "\tFALSE\t/\tFALSE\t0\tauth_token\ttoken\n" +
+		host + "\tFALSE\t/\tFALSE\t4102444800\tct0\tcsrf\n"
+	scraper := twitterscraper.New().WithBaseURLs(twitterscraper.BaseURLs{API: srv.URL, Web: srv.URL})
+	if err := scraper.ImportCookiesTxt(strings.NewReader(txt)); err != nil {
+		t.Fatal(err)
+	}
+	if !scraper.IsLoggedIn(ctx) {
+		t.Error("Expected logged in with cookies.txt")
+	}
+
+	jsn := fmt.Sprintf(`[
+		{"domain":%q,"name":"auth_token","value":"token","path":"/","hostOnly":true,"httpOnly":true},
+		{"domain":%q,"name":"ct0","value":"csrf","path":"/","hostOnly":true,"expirationDate":4102444800.5}
+	]`, host, host)
+	scraper = twitterscraper.New().WithBaseURLs(twitterscraper.BaseURLs{API: srv.URL, Web: srv.URL})
+	if err := scraper.ImportCookiesJSON(strings.NewReader(jsn)); err != nil {
+		t.Fatal(err)
+	}
+	if !scraper.IsLoggedIn(ctx) {
+		t.Error("Expected logged in with JSON cookies")
+	}
+
+	if err := scraper.ImportCookiesTxt(strings.NewReader("broken line")); err == nil {
+		t.Error("Expected error on malformed cookies.txt")
+	}
+}
@@ -0,0 +1,198 @@
+package twitterscraper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Endpoint name of Twitter API request
+type Endpoint string
+
+// Endpoints used by Scraper, paths can be overridden with WithEndpoint
+const (
+	EndpointGuestActivate      Endpoint = "GuestActivate"
+	EndpointUserByScreenName   Endpoint = "UserByScreenName"
+	EndpointProfileTimeline    Endpoint = "ProfileTimeline"
+	EndpointHomeTimeline       Endpoint = "HomeTimeline"
+	EndpointHomeLatestTimeline Endpoint = "HomeLatestTimeline"
+	EndpointConversation       Endpoint = "Conversation"
+	EndpointSearch             Endpoint = "Search"
+	EndpointGuide              Endpoint = "Guide"
+	EndpointFriendshipsCreate  Endpoint = "FriendshipsCreate"
+	EndpointFriendshipsDestroy Endpoint = "FriendshipsDestroy"
+	EndpointOnboardingTask     Endpoint = "OnboardingTask"
+	EndpointLogout             Endpoint = "Logout"
+	EndpointVerifyCredentials  Endpoint = "VerifyCredentials"
+	EndpointFollowers          Endpoint = "Followers"
+	EndpointFollowing          Endpoint = "Following"
+	EndpointLikes              Endpoint = "Likes"
+	EndpointUserMedia          Endpoint = "UserMedia"
+	EndpointRetweeters         Endpoint = "Retweeters"
+	EndpointFavoriters         Endpoint = "Favoriters"
+	EndpointListByRestID       Endpoint = "ListByRestId"
+	EndpointListTweets         Endpoint = "ListLatestTweetsTimeline"
+	EndpointListMembers        Endpoint = "ListMembers"
+	EndpointListSubscribers    Endpoint = "ListSubscribers"
+	EndpointListMemberships    Endpoint = "ListMemberships"
+	EndpointListCreate         Endpoint = "ListCreate"
+	EndpointListUpdate         Endpoint = "ListUpdate"
+	EndpointListDestroy        Endpoint = "ListDestroy"
+	EndpointListMembersCreate  Endpoint = "ListMembersCreate"
+	EndpointListMembersDestroy Endpoint = "ListMembersDestroy"
+	EndpointListSubscribe      Endpoint = "ListSubscribe"
+	EndpointTrendsPlace        Endpoint = "TrendsPlace"
+	EndpointTrendsAvailable    Endpoint = "TrendsAvailable"
+	EndpointBookmarks          Endpoint = "Bookmarks"
+	EndpointCreateBookmark     Endpoint = "CreateBookmark"
+	EndpointDeleteBookmark     Endpoint = "DeleteBookmark"
+	EndpointNotifications      Endpoint = "Notifications"
+	EndpointMentions           Endpoint = "Mentions"
+	EndpointCreateTweet        Endpoint = "CreateTweet"
+	EndpointDeleteTweet        Endpoint = "DeleteTweet"
+	EndpointCardsCreate        Endpoint = "CardsCreate"
+	EndpointFavoritesCreate    Endpoint = "FavoritesCreate"
+	EndpointFavoritesDestroy   Endpoint = "FavoritesDestroy"
+	EndpointRetweet            Endpoint = "Retweet"
+	EndpointUnretweet          Endpoint = "Unretweet"
+	EndpointPinTweet           Endpoint = "PinTweet"
+	EndpointUnpinTweet         Endpoint = "UnpinTweet"
+)
+
+// BaseURLs of Twitter hosts
+type BaseURLs struct {
+	// API host, used by guest token activation, profiles and user timelines
+	API string
+	// Web host, used by `/i/api` endpoints (search, home timeline, trends...)
+	Web string
+	// Caps host, used by cards of polls
+	Caps string
+}
+
+// DefaultBaseURLs of Twitter hosts
+var DefaultBaseURLs = BaseURLs{
+	API:  "https://api.twitter.com",
+	Web:  "https://twitter.com",
+	Caps: "https://caps.twitter.com",
+}
+
+type host int
+
+const (
+	hostAPI host = iota
+	hostWeb
+	hostCaps
+)
+
+type endpoint struct {
+	host host
+	path string
+}
+
+// default endpoints, path may contain fmt verbs filled by request arguments
+var defaultEndpoints = map[Endpoint]endpoint{
+	EndpointGuestActivate:      {hostAPI, "/1.1/guest/activate.json"},
+	EndpointUserByScreenName:   {hostAPI, "/graphql/4S2ihIKfF3xhp-ENxvUAfQ/UserByScreenName"},
+	EndpointProfileTimeline:    {hostAPI, "/2/timeline/profile/%s.json"},
+	EndpointHomeTimeline:       {hostWeb, "/i/api/2/timeline/home.json"},
+	EndpointHomeLatestTimeline: {hostWeb, "/i/api/2/timeline/home_latest.json"},
+	EndpointConversation:       {hostWeb, "/i/api/2/timeline/conversation/%s.json"},
+	EndpointSearch:             {hostWeb, "/i/api/2/search/adaptive.json"},
+	EndpointGuide:              {hostWeb, "/i/api/2/guide.json"},
+	EndpointFriendshipsCreate:  {hostWeb, "/i/api/1.1/friendships/create.json"},
+	EndpointFriendshipsDestroy: {hostWeb, "/i/api/1.1/friendships/destroy.json"},
+	EndpointOnboardingTask:     {hostAPI, "/1.1/onboarding/task.json"},
+	EndpointLogout:             {hostAPI, "/1.1/account/logout.json"},
+	EndpointVerifyCredentials:  {hostAPI, "/1.1/account/verify_credentials.json"},
+	EndpointFollowers:          {hostAPI, "/graphql/rRXFSG5vR6drKr5M37YOTw/Followers"},
+	EndpointFollowing:          {hostAPI, "/graphql/iSicc7LrzWGBgDPL0tM_TQ/Following"},
+	EndpointLikes:              {hostAPI, "/graphql/eSSNbhECHHWWALkkQq-YTA/Likes"},
+	EndpointUserMedia:          {hostAPI, "/graphql/Le6KlbilFmSu-5VltFND-Q/UserMedia"},
+	EndpointRetweeters:         {hostAPI, "/graphql/ViKvXirbgcKs7fKF7gLEsw/Retweeters"},
+	EndpointFavoriters:         {hostAPI, "/graphql/vcTrPlh9ovFDQejz22q9vg/Favoriters"},
+	EndpointListByRestID:       {hostAPI, "/graphql/wXzyA5vM_aVkBL9G8Vp3kw/ListByRestId"},
+	EndpointListTweets:         {hostAPI, "/graphql/2TemLyqrMpTeAmysdbnVqw/ListLatestTweetsTimeline"},
+	EndpointListMembers:        {hostAPI, "/graphql/BQp2IEYkgxuSxqbTAr1e1g/ListMembers"},
+	EndpointListSubscribers:    {hostAPI, "/graphql/74wGEkaBxrdoXakWTWMxRQ/ListSubscribers"},
+	EndpointListMemberships:    {hostAPI, "/graphql/BlEXXdARdSeL_0KyKHHvvg/ListMemberships"},
+	EndpointListCreate:         {hostWeb, "/i/api/1.1/lists/create.json"},
+	EndpointListUpdate:         {hostWeb, "/i/api/1.1/lists/update.json"},
+	EndpointListDestroy:        {hostWeb, "/i/api/1.1/lists/destroy.json"},
+	EndpointListMembersCreate:  {hostWeb, "/i/api/1.1/lists/members/create.json"},
+	EndpointListMembersDestroy: {hostWeb, "/i/api/1.1/lists/members/destroy.json"},
+	EndpointListSubscribe:      {hostWeb, "/i/api/1.1/lists/subscribers/create.json"},
+	EndpointTrendsPlace:        {hostAPI, "/1.1/trends/place.json"},
+	EndpointTrendsAvailable:    {hostAPI, "/1.1/trends/available.json"},
+	EndpointBookmarks:          {hostWeb, "/i/api/graphql/3OjEFzT2VjX-X7w4KYBJRg/Bookmarks"},
+	EndpointCreateBookmark:     {hostWeb, "/i/api/graphql/aoDbu3RHznuiSkQ9aNM67Q/CreateBookmark"},
+	EndpointDeleteBookmark:     {hostWeb, "/i/api/graphql/Wlmlj2-xzyS1GN3a6cj-mQ/DeleteBookmark"},
+	EndpointNotifications:      {hostWeb, "/i/api/2/notifications/all.json"},
+	EndpointMentions:           {hostWeb, "/i/api/2/notifications/mentions.json"},
+	EndpointCreateTweet:        {hostWeb, "/i/api/graphql/SoVnbfCycZ7fERGCwpZkYA/CreateTweet"},
+	EndpointDeleteTweet:        {hostWeb, "/i/api/graphql/VaenaVgh5q5ih7kvyVjgtg/DeleteTweet"},
+	EndpointCardsCreate:        {hostCaps, "/v2/cards/create.json"},
+	EndpointFavoritesCreate:    {hostWeb, "/i/api/1.1/favorites/create.json"},
+	EndpointFavoritesDestroy:   {hostWeb, "/i/api/1.1/favorites/destroy.json"},
+	EndpointRetweet:            {hostWeb, "/i/api/1.1/statuses/retweet/%s.json"},
+	EndpointUnretweet:          {hostWeb, "/i/api/1.1/statuses/unretweet/%s.json"},
+	EndpointPinTweet:           {hostWeb, "/i/api/1.1/account/pin_tweet.json"},
+	EndpointUnpinTweet:         {hostWeb, "/i/api/1.1/account/unpin_tweet.json"},
+}
+
+// WithBaseURLs set hosts used to build API requests, empty fields are left unchanged.
+// Session cookies are copied to new hosts.
+func (s *Scraper) WithBaseURLs(urls BaseURLs) *Scraper {
+	cookies := s.sessionCookies()
+	s.mu.Lock()
+	if urls.API != "" {
+		s.baseURLs.API = strings.TrimSuffix(urls.API, "/")
+	}
+	if urls.Web != "" {
+		s.baseURLs.Web = strings.TrimSuffix(urls.Web, "/")
+	}
+	if urls.Caps != "" {
+		s.baseURLs.Caps = strings.TrimSuffix(urls.Caps, "/")
+	}
+	hosts := []string{s.baseURLs.API, s.baseURLs.Web, s.baseURLs.Caps}
+	s.mu.Unlock()
+	s.copyCookies(cookies, hosts)
+	return s
+}
+
+// WithEndpoint override path of endpoint on its host,
+// absolute URL (`https://HOST/PATH`) replaces the host too.
+// Paths of ProfileTimeline, Conversation, Retweet and Unretweet must keep
+// the `%s` verb, it is replaced with user or tweet ID of request.
+func (s *Scraper) WithEndpoint(name Endpoint, path string) *Scraper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.endpoints == nil {
+		s.endpoints = make(map[Endpoint]string)
+	}
+	s.endpoints[name] = path
+	return s
+}
+
+// endpointURL build URL of endpoint with path arguments
+func (s *Scraper) endpointURL(name Endpoint, args ...interface{}) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e := defaultEndpoints[name]
+	if path, ok := s.endpoints[name]; ok {
+		e.path = path
+	}
+	path := e.path
+	if len(args) > 0 {
+		path = fmt.Sprintf(path, args...)
+	}
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	switch e.host {
+	case hostWeb:
+		return s.baseURLs.Web + path
+	case hostCaps:
+		return s.baseURLs.Caps + path
+	default:
+		return s.baseURLs.API + path
+	}
+}
@@ -0,0 +1,90 @@
+package twitterscraper
+
+import (
+	"context"
+)
+
+// tweetUsers JSON object of Retweeters and Favoriters timelines
+type tweetUsers struct {
+	Data struct {
+		RetweetersTimeline struct {
+			Timeline graphQLTimeline `json:"timeline"`
+		} `json:"retweeters_timeline"`
+		FavoritersTimeline struct {
+			Timeline graphQLTimeline `json:"timeline"`
+		} `json:"favoriters_timeline"`
+	} `json:"data"`
+	Errors []APIErrorDetail `json:"errors"`
+}
+
+// GetRetweeters returns channel with profiles who retweeted a given tweet.
+func (s *Scraper) GetRetweeters(ctx context.Context, tweetID string, maxProfilesNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, tweetID, maxProfilesNbr, s.FetchRetweetersWithContext)
+}
+
+// GetLikers returns channel with profiles who liked a given tweet.
+func (s *Scraper) GetLikers(ctx context.Context, tweetID string, maxProfilesNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, tweetID, maxProfilesNbr, s.FetchLikersWithContext)
+}
+
+// FetchRetweeters gets profiles who retweeted a given tweet, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchRetweeters(tweetID string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.FetchRetweetersWithContext(context.Background(), tweetID, maxProfilesNbr, cursor)
+}
+
+// FetchRetweetersWithContext gets profiles who retweeted a given tweet, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchRetweetersWithContext(ctx context.Context, tweetID string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.fetchTweetUsers(ctx, EndpointRetweeters, tweetID, maxProfilesNbr, cursor)
+}
+
+// FetchLikers gets profiles who liked a given tweet, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchLikers(tweetID string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.FetchLikersWithContext(context.Background(), tweetID, maxProfilesNbr, cursor)
+}
+
+// FetchLikersWithContext gets profiles who liked a given tweet, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchLikersWithContext(ctx context.Context, tweetID string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.fetchTweetUsers(ctx, EndpointFavoriters, tweetID, maxProfilesNbr, cursor)
+}
+
+func (s *Scraper) fetchTweetUsers(ctx context.Context, endpoint Endpoint, tweetID string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, "", err
+	}
+
+	if maxProfilesNbr > 100 {
+		maxProfilesNbr = 100
+	}
+
+	variables := map[string]interface{}{
+		"tweetId":                tweetID,
+		"count":                  maxProfilesNbr,
+		"includePromotedContent": false,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+	req, err := s.newGraphQLRequest(ctx, endpoint, variables)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var jsn tweetUsers
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, "", err
+	}
+
+	timeline := &jsn.Data.RetweetersTimeline.Timeline
+	if endpoint == EndpointFavoriters {
+		timeline = &jsn.Data.FavoritersTimeline.Timeline
+	}
+	if len(timeline.Instructions) == 0 {
+		if err := graphQLError(jsn.Errors); err != nil {
+			return nil, "", err
+		}
+	}
+
+	users, nextCursor := timeline.toTimeline().parseUsers()
+	return users, nextCursor, nil
+}
@@ -0,0 +1,50 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+func TestGetRetweetersAndLikers(t *testing.T) {
+	mux := http.NewServeMux()
+	handleTweetUsers := func(path, timeline string, names ...string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			variables := r.URL.Query().Get("variables")
+			if !strings.Contains(variables, `"tweetId":"300"`) {
+				t.Errorf("Expected tweetId 300, got %s", variables)
+			}
+			instruction := userEntries("page2", names...)
+			if strings.Contains(variables, `"cursor":"page2"`) {
+				instruction = userEntries("page3")
+			}
+			fmt.Fprintf(w, `{"data":{%q:{"timeline":{"instructions":[%s]}}}}`, timeline, instruction)
+		})
+	}
+	handleTweetUsers("/graphql/ViKvXirbgcKs7fKF7gLEsw/Retweeters", "retweeters_timeline", "alice", "bob")
+	handleTweetUsers("/graphql/vcTrPlh9ovFDQejz22q9vg/Favoriters", "favoriters_timeline", "carol")
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	collect := func(profiles <-chan *twitterscraper.ProfileResult) string {
+		var names []string
+		for profile := range profiles {
+			if profile.Error != nil {
+				t.Fatal(profile.Error)
+			}
+			names = append(names, profile.Username)
+		}
+		return strings.Join(names, ",")
+	}
+	if names := collect(scraper.GetRetweeters(context.Background(), "300", 10)); names != "alice,bob" {
+		t.Errorf("Expected retweeters alice,bob, got %s", names)
+	}
+	if names := collect(scraper.GetLikers(context.Background(), "300", 10)); names != "carol" {
+		t.Errorf("Expected likers carol, got %s", names)
+	}
+}
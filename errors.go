@@ -0,0 +1,134 @@
+package twitterscraper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Errors returned by Scraper, test them with errors.Is
+var (
+	// ErrRateLimited rate limit exceeded, reset time is in APIError.RateLimitReset
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrNotFound user, tweet or page does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrSuspended account is suspended
+	ErrSuspended = errors.New("account suspended")
+	// ErrProtected account or content is protected
+	ErrProtected = errors.New("account protected")
+	// ErrAuthRequired cookie authentication is missing or invalid
+	ErrAuthRequired = errors.New("authentication required")
+	// ErrAlreadyDone action has no effect, e.g. tweet is already liked or is not pinned
+	ErrAlreadyDone = errors.New("action already done")
+	// ErrNotOwned tweet is not of authenticated user
+	ErrNotOwned = errors.New("not owned by authenticated user")
+)
+
+// APIErrorDetail from `errors` array of Twitter API response
+type APIErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// APIError of failed API request
+type APIError struct {
+	// HTTP status code of response
+	StatusCode int
+	// HTTP status of response
+	Status string
+	// Errors reported by Twitter
+	Errors []APIErrorDetail
+	// RateLimitReset from X-Rate-Limit-Reset header, zero if not set
+	RateLimitReset time.Time
+	// Body of response
+	Body string
+}
+
+// Twitter error codes
+// https://developer.twitter.com/en/support/twitter-api/error-troubleshooting
+var errorCodes = map[int]error{
+	8:   ErrNotFound,
+	32:  ErrAuthRequired,
+	34:  ErrNotFound,
+	50:  ErrNotFound,
+	63:  ErrSuspended,
+	64:  ErrSuspended,
+	88:  ErrRateLimited,
+	89:  ErrAuthRequired,
+	144: ErrNotFound,
+	179: ErrProtected,
+	215: ErrAuthRequired,
+	220: ErrAuthRequired,
+	239: ErrAuthRequired,
+	326: ErrAuthRequired,
+	353: ErrAuthRequired,
+	399: ErrAuthRequired,
+}
+
+func (e *APIError) Error() string {
+	if e.StatusCode != http.StatusOK || len(e.Errors) == 0 {
+		return fmt.Sprintf("response status %s: %s", e.Status, e.Body)
+	}
+	// errors of successful GraphQL response
+	var messages []string
+	for _, detail := range e.Errors {
+		messages = append(messages, detail.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Code of first Twitter error, 0 if none
+func (e *APIError) Code() int {
+	if len(e.Errors) > 0 {
+		return e.Errors[0].Code
+	}
+	return 0
+}
+
+// Unwrap to sentinel error matching Twitter error code or HTTP status
+func (e *APIError) Unwrap() error {
+	for _, detail := range e.Errors {
+		if err, ok := errorCodes[detail.Code]; ok {
+			return err
+		}
+	}
+	switch e.StatusCode {
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return ErrAuthRequired
+	}
+	return nil
+}
+
+// newAPIError from response and its content
+func newAPIError(resp *http.Response, content []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode:     resp.StatusCode,
+		Status:         resp.Status,
+		RateLimitReset: rateLimitReset(resp),
+		Body:           string(content),
+	}
+	var jsn struct {
+		Errors []APIErrorDetail `json:"errors"`
+	}
+	if err := json.Unmarshal(content, &jsn); err == nil {
+		apiErr.Errors = jsn.Errors
+	}
+	return apiErr
+}
+
+// onlyErrors check if response content has nothing but `errors` array
+func onlyErrors(content []byte) bool {
+	var jsn map[string]json.RawMessage
+	if err := json.Unmarshal(content, &jsn); err != nil {
+		return false
+	}
+	_, ok := jsn["errors"]
+	return ok && len(jsn) == 1
+}
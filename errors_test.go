@@ -0,0 +1,69 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+func TestAPIError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Reset", "1700000000")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"errors":[{"code":88,"message":"Rate limit exceeded"}]}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	scraper.WithRetry(twitterscraper.RetryPolicy{MaxAttempts: 1})
+	_, _, err := scraper.FetchSearchTweets("twitter", 20, "")
+	if !errors.Is(err, twitterscraper.ErrRateLimited) {
+		t.Fatalf("Expected ErrRateLimited, got %v", err)
+	}
+	var apiErr *twitterscraper.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusTooManyRequests || apiErr.Code() != 88 {
+		t.Errorf("Unexpected status %d and code %d", apiErr.StatusCode, apiErr.Code())
+	}
+	if !apiErr.RateLimitReset.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Unexpected rate limit reset %s", apiErr.RateLimitReset)
+	}
+}
+
+func TestGetProfileErrors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql/4S2ihIKfF3xhp-ENxvUAfQ/UserByScreenName", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("variables") {
+		case `{"screen_name":"suspended","withHighlightedLabel":true}`:
+			fmt.Fprint(w, `{"errors":[{"code":63,"message":"User has been suspended."}]}`)
+		default:
+			fmt.Fprint(w, `{"data":{}}`)
+		}
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	if _, err := scraper.GetProfile("suspended"); !errors.Is(err, twitterscraper.ErrSuspended) {
+		t.Errorf("Expected ErrSuspended, got %v", err)
+	}
+	if _, err := scraper.GetProfile("missing"); !errors.Is(err, twitterscraper.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestAuthRequired(t *testing.T) {
+	scraper := twitterscraper.New()
+	for tweet := range scraper.GetHomeTimeline(context.Background(), 20) {
+		if !errors.Is(tweet.Error, twitterscraper.ErrAuthRequired) {
+			t.Errorf("Expected ErrAuthRequired, got %v", tweet.Error)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package twitterscraper
+
+import (
+	"context"
+)
+
+// ExploreTab of Explore page
+type ExploreTab string
+
+const (
+	// ExploreForYou - personalized tab
+	ExploreForYou ExploreTab = "for-you"
+	// ExploreTrending - trends tab
+	ExploreTrending ExploreTab = "trending"
+	// ExploreNews - news tab
+	ExploreNews ExploreTab = "news_unified"
+	// ExploreSports - sports tab
+	ExploreSports ExploreTab = "sports_unified"
+	// ExploreEntertainment - entertainment tab
+	ExploreEntertainment ExploreTab = "entertainment_unified"
+)
+
+// ExploreModule of Explore tab, e.g. "Trends for you".
+type ExploreModule struct {
+	Title  string
+	Trends []Trend
+	Events []ExploreEvent
+	Tweets []*Tweet
+}
+
+// ExploreEvent summary of Explore tab.
+type ExploreEvent struct {
+	ID          string
+	Title       string
+	Description string
+	Image       string
+	URL         string
+}
+
+// GetExploreTab return modules of Explore tab.
+func (s *Scraper) GetExploreTab(ctx context.Context, tab ExploreTab) ([]ExploreModule, error) {
+	req, err := s.newRequest(withBearerToken(ctx, bearerToken2), "GET", s.endpointURL(EndpointGuide))
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("count", "20")
+	q.Add("initial_tab_id", string(tab))
+	q.Add("include_page_configuration", "false")
+	q.Add("entity_tokens", "false")
+	q.Add("tweet_mode", "extended")
+	req.URL.RawQuery = q.Encode()
+
+	var jsn timeline
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsn.parseExploreModules(), nil
+}
+
+// SearchTrendTweets returns channel with tweets of trend.
+func (s *Scraper) SearchTrendTweets(ctx context.Context, trend Trend, maxTweetsNbr int) <-chan *TweetResult {
+	query := trend.Query
+	if query == "" {
+		query = trend.Name
+	}
+	return s.SearchTweets(ctx, query, maxTweetsNbr)
+}
+
+// parseExploreModules of guide timeline, standalone entries are grouped into untitled modules
+func (timeline *timeline) parseExploreModules() []ExploreModule {
+	var modules []ExploreModule
+	var standalone *ExploreModule
+	for _, instruction := range timeline.Timeline.Instructions {
+		for _, entry := range instruction.AddEntries.Entries {
+			module := ExploreModule{Title: entry.Content.TimelineModule.Header.Text}
+			for _, item := range entry.Content.TimelineModule.Items {
+				content := item.Item.Content
+				name := item.Item.ClientEventInfo.Details.GuideDetails.TransparentGuideDetails.TrendMetadata.TrendName
+				if trend, ok := parseTrend(content.Trend, name, len(module.Trends)+1); ok {
+					module.Trends = append(module.Trends, trend)
+				}
+				module.addEvent(content.EventSummary)
+				module.addTweet(timeline, content.Tweet.ID)
+			}
+			if module.empty() {
+				if standalone == nil {
+					standalone = &ExploreModule{}
+				}
+				standalone.addEvent(entry.Content.Item.Content.EventSummary)
+				standalone.addTweet(timeline, entry.Content.Item.Content.Tweet.ID)
+				continue
+			}
+			if standalone != nil && !standalone.empty() {
+				modules = append(modules, *standalone)
+			}
+			standalone = nil
+			modules = append(modules, module)
+		}
+	}
+	if standalone != nil && !standalone.empty() {
+		modules = append(modules, *standalone)
+	}
+	return modules
+}
+
+func (module *ExploreModule) addEvent(event timelineEventSummary) {
+	if event.ID == "" && event.Title == "" {
+		return
+	}
+	module.Events = append(module.Events, ExploreEvent{
+		ID:          event.ID,
+		Title:       event.Title,
+		Description: event.SupportingText,
+		Image:       event.Image.URL,
+		URL:         event.URL.URL,
+	})
+}
+
+func (module *ExploreModule) addTweet(timeline *timeline, id string) {
+	if id == "" {
+		return
+	}
+	if tweet := timeline.parseTweet(id); tweet != nil {
+		module.Tweets = append(module.Tweets, tweet)
+	}
+}
+
+func (module *ExploreModule) empty() bool {
+	return len(module.Trends) == 0 && len(module.Events) == 0 && len(module.Tweets) == 0
+}
@@ -0,0 +1,57 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+func TestGetExploreTab(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/guide.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("initial_tab_id") != "news_unified" {
+			t.Errorf("Expected news tab, got %s", r.URL.Query().Get("initial_tab_id"))
+		}
+		fmt.Fprint(w, `{"globalObjects":{"tweets":{"900":{"id_str":"900","full_text":"breaking","user_id_str":"10"}},`+
+			`"users":{"10":{"id_str":"10","screen_name":"alice"}}},"timeline":{"instructions":[{"addEntries":{"entries":[`+
+			`{"entryId":"event-1","content":{"item":{"content":{"eventSummary":{"id":"1","title":"Launch",`+
+			`"supportingText":"Rocket launched","image":{"url":"https://example.com/1.jpg"},"url":{"url":"https://twitter.com/i/events/1"}}}}}},`+
+			`{"entryId":"trends","content":{"timelineModule":{"header":{"text":"Trends for you"},"items":[`+
+			`{"entryId":"trend-1","item":{"content":{"trend":{"name":"#Go","trendMetadata":{"metaDescription":"1,200 Tweets"}}}}}]}}},`+
+			`{"entryId":"tweets","content":{"timelineModule":{"header":{"text":"Top stories"},"items":[`+
+			`{"entryId":"tweet-900","item":{"content":{"tweet":{"id":"900"}}}}]}}}]}}]}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	modules, err := scraper.GetExploreTab(context.Background(), twitterscraper.ExploreNews)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(modules) != 3 {
+		t.Fatalf("Expected 3 modules, got %+v", modules)
+	}
+	if len(modules[0].Events) != 1 || modules[0].Events[0].Title != "Launch" || modules[0].Events[0].URL != "https://twitter.com/i/events/1" {
+		t.Errorf("Unexpected events %+v", modules[0])
+	}
+	trends := modules[1].Trends
+	if modules[1].Title != "Trends for you" || len(trends) != 1 || trends[0].Query != "#Go" || trends[0].TweetVolume != 1200 || trends[0].Rank != 1 {
+		t.Errorf("Unexpected trends %+v", modules[1])
+	}
+	if len(modules[2].Tweets) != 1 || modules[2].Tweets[0].Username != "alice" {
+		t.Errorf("Unexpected tweets %+v", modules[2])
+	}
+
+	mux = http.NewServeMux()
+	mux.HandleFunc("/i/api/2/guide.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"timeline":{"instructions":[]}}`)
+	})
+	srv, scraper = newTestServer(mux)
+	defer srv.Close()
+	if modules, err := scraper.GetExploreTab(context.Background(), twitterscraper.ExploreSports); err != nil || len(modules) != 0 {
+		t.Errorf("Expected no modules, got %v %v", modules, err)
+	}
+}
@@ -0,0 +1,53 @@
+package twitterscraper
+
+import (
+	"context"
+)
+
+// GetFollowers returns channel with profiles following a given user.
+func (s *Scraper) GetFollowers(ctx context.Context, user string, maxProfilesNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, user, maxProfilesNbr, s.FetchFollowersWithContext)
+}
+
+// GetFollowing returns channel with profiles followed by a given user.
+func (s *Scraper) GetFollowing(ctx context.Context, user string, maxProfilesNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, user, maxProfilesNbr, s.FetchFollowingWithContext)
+}
+
+// FetchFollowers gets profiles following a given user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchFollowers(user string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.FetchFollowersWithContext(context.Background(), user, maxProfilesNbr, cursor)
+}
+
+// FetchFollowersWithContext gets profiles following a given user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchFollowersWithContext(ctx context.Context, user string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.fetchFollows(ctx, EndpointFollowers, user, maxProfilesNbr, cursor)
+}
+
+// FetchFollowing gets profiles followed by a given user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchFollowing(user string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.FetchFollowingWithContext(context.Background(), user, maxProfilesNbr, cursor)
+}
+
+// FetchFollowingWithContext gets profiles followed by a given user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchFollowingWithContext(ctx context.Context, user string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.fetchFollows(ctx, EndpointFollowing, user, maxProfilesNbr, cursor)
+}
+
+func (s *Scraper) fetchFollows(ctx context.Context, endpoint Endpoint, user string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, "", err
+	}
+
+	if maxProfilesNbr > 50 {
+		maxProfilesNbr = 50
+	}
+
+	timeline, err := s.fetchUserTimeline(ctx, endpoint, user, maxProfilesNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users, nextCursor := timeline.toTimeline().parseUsers()
+	return users, nextCursor, nil
+}
@@ -0,0 +1,84 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+// handleUserByScreenName respond with rest_id of user
+func handleUserByScreenName(mux *http.ServeMux, screenName, id string) {
+	mux.HandleFunc("/graphql/4S2ihIKfF3xhp-ENxvUAfQ/UserByScreenName", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":{"user":{"rest_id":%q,"legacy":{"screen_name":%q}}}}`, id, screenName)
+	})
+}
+
+// userEntries of GraphQL timeline with users and bottom cursor
+func userEntries(cursor string, names ...string) string {
+	var entries []string
+	for _, name := range names {
+		entries = append(entries, fmt.Sprintf(`{"entryId":"user-%[1]s","content":{"entryType":"TimelineTimelineItem",`+
+			`"itemContent":{"itemType":"TimelineUser","user_results":{"result":{"__typename":"User","rest_id":"id-%[1]s",`+
+			`"legacy":{"screen_name":%[1]q,"name":%[1]q,"followers_count":1}}}}}}`, name))
+	}
+	entries = append(entries, fmt.Sprintf(`{"entryId":"cursor-bottom","content":{"entryType":"TimelineTimelineCursor","cursorType":"Bottom","value":%q}}`, cursor))
+	return `{"type":"TimelineAddEntries","entries":[` + strings.Join(entries, ",") + `]}`
+}
+
+func TestGetFollowers(t *testing.T) {
+	mux := http.NewServeMux()
+	handleUserByScreenName(mux, "followed_user", "42")
+	mux.HandleFunc("/graphql/rRXFSG5vR6drKr5M37YOTw/Followers", func(w http.ResponseWriter, r *http.Request) {
+		var variables struct {
+			UserID string `json:"userId"`
+			Cursor string `json:"cursor"`
+		}
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("variables")), &variables); err != nil {
+			t.Error(err)
+			return
+		}
+		if variables.UserID != "42" {
+			t.Errorf("Expected userId 42, got %s", variables.UserID)
+		}
+		if r.URL.Query().Get("features") == "" {
+			t.Error("Expected features param")
+		}
+		var instruction string
+		switch variables.Cursor {
+		case "":
+			instruction = userEntries("page2", "alice", "bob")
+		case "page2":
+			instruction = userEntries("page3", "carol")
+		default:
+			instruction = userEntries("page4")
+		}
+		fmt.Fprintf(w, `{"data":{"user":{"result":{"__typename":"User","timeline":{"timeline":{"instructions":[%s]}}}}}}`, instruction)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	if err := (<-scraper.GetFollowers(context.Background(), "followed_user", 10)).Error; !errors.Is(err, twitterscraper.ErrAuthRequired) {
+		t.Errorf("Expected ErrAuthRequired without cookies, got %v", err)
+	}
+
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+	var names []string
+	for profile := range scraper.GetFollowers(context.Background(), "followed_user", 10) {
+		if profile.Error != nil {
+			t.Fatal(profile.Error)
+		}
+		if profile.UserID != "id-"+profile.Username {
+			t.Errorf("Unexpected user ID %s of %s", profile.UserID, profile.Username)
+		}
+		names = append(names, profile.Username)
+	}
+	if strings.Join(names, ",") != "alice,bob,carol" {
+		t.Errorf("Expected alice,bob,carol, got %v", names)
+	}
+}
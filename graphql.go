@@ -0,0 +1,308 @@
+package twitterscraper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// graphQLFeatures of timeline requests
+var graphQLFeatures = map[string]interface{}{
+	"responsive_web_graphql_exclude_directive_enabled":                  true,
+	"verified_phone_label_enabled":                                      false,
+	"responsive_web_graphql_timeline_navigation_enabled":                true,
+	"responsive_web_graphql_skip_user_profile_image_extensions_enabled": false,
+	"tweetypie_unmention_optimization_enabled":                          true,
+	"vibe_api_enabled":                                                        true,
+	"responsive_web_edit_tweet_api_enabled":                                   true,
+	"graphql_is_translatable_rweb_tweet_is_translatable_enabled":              true,
+	"view_counts_everywhere_api_enabled":                                      true,
+	"longform_notetweets_consumption_enabled":                                 true,
+	"tweet_awards_web_tipping_enabled":                                        false,
+	"freedom_of_speech_not_reach_fetch_enabled":                               false,
+	"standardized_nudges_misinfo":                                             true,
+	"tweet_with_visibility_results_prefer_gql_limited_actions_policy_enabled": false,
+	"interactive_text_enabled":                                                true,
+	"responsive_web_text_conversations_enabled":                               false,
+	"responsive_web_enhance_cards_enabled":                                    false,
+}
+
+type userResult struct {
+	RestID string     `json:"rest_id"`
+	Legacy legacyUser `json:"legacy"`
+}
+
+type tweetResult struct {
+	RestID string `json:"rest_id"`
+	Core   struct {
+		UserResults struct {
+			Result *userResult `json:"result"`
+		} `json:"user_results"`
+	} `json:"core"`
+	Legacy struct {
+		legacyTweet
+		RetweetedStatusResult struct {
+			Result *tweetResult `json:"result"`
+		} `json:"retweeted_status_result"`
+	} `json:"legacy"`
+	QuotedStatusResult struct {
+		Result *tweetResult `json:"result"`
+	} `json:"quoted_status_result"`
+	// Tweet of TweetWithVisibilityResults
+	Tweet *tweetResult `json:"tweet"`
+}
+
+type graphQLItem struct {
+	ItemType     string `json:"itemType"`
+	TweetResults struct {
+		Result *tweetResult `json:"result"`
+	} `json:"tweet_results"`
+	UserResults struct {
+		Result *userResult `json:"result"`
+	} `json:"user_results"`
+	List          *legacyList  `json:"list"`
+	SocialContext *interface{} `json:"socialContext,omitempty"`
+}
+
+type graphQLModuleItem struct {
+	EntryID string `json:"entryId"`
+	Item    struct {
+		ItemContent graphQLItem `json:"itemContent"`
+	} `json:"item"`
+}
+
+type graphQLEntry struct {
+	EntryID string `json:"entryId"`
+	Content struct {
+		EntryType   string              `json:"entryType"`
+		CursorType  string              `json:"cursorType"`
+		Value       string              `json:"value"`
+		ItemContent graphQLItem         `json:"itemContent"`
+		Items       []graphQLModuleItem `json:"items"`
+	} `json:"content"`
+}
+
+// graphQLTimeline JSON object
+type graphQLTimeline struct {
+	Instructions []struct {
+		Type    string         `json:"type"`
+		Entries []graphQLEntry `json:"entries"`
+		Entry   graphQLEntry   `json:"entry"`
+		// ModuleItems of TimelineAddToModule, e.g. next page of media grid
+		ModuleItems []graphQLModuleItem `json:"moduleItems"`
+	} `json:"instructions"`
+}
+
+// userTimeline JSON object of user GraphQL timelines
+type userTimeline struct {
+	Data struct {
+		User struct {
+			Result struct {
+				Typename string `json:"__typename"`
+				Timeline struct {
+					Timeline graphQLTimeline `json:"timeline"`
+				} `json:"timeline"`
+				TimelineV2 struct {
+					Timeline graphQLTimeline `json:"timeline"`
+				} `json:"timeline_v2"`
+			} `json:"result"`
+		} `json:"user"`
+	} `json:"data"`
+	Errors []APIErrorDetail `json:"errors"`
+}
+
+// timeline of response, user timelines are either in timeline or timeline_v2
+func (t *userTimeline) timeline() *graphQLTimeline {
+	if len(t.Data.User.Result.TimelineV2.Timeline.Instructions) > 0 {
+		return &t.Data.User.Result.TimelineV2.Timeline
+	}
+	return &t.Data.User.Result.Timeline.Timeline
+}
+
+// newGraphQLRequest of endpoint with variables and features query params
+func (s *Scraper) newGraphQLRequest(ctx context.Context, endpoint Endpoint, variables map[string]interface{}) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", s.endpointURL(endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	vars, err := json.Marshal(variables)
+	if err != nil {
+		return nil, err
+	}
+	features, err := json.Marshal(graphQLFeatures)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Add("variables", string(vars))
+	q.Add("features", string(features))
+	req.URL.RawQuery = q.Encode()
+	return req, nil
+}
+
+// newGraphQLMutation of endpoint with variables and features posted in JSON body,
+// query ID is taken from endpoint path (`/graphql/ID/NAME`), features are omitted if nil
+func (s *Scraper) newGraphQLMutation(ctx context.Context, endpoint Endpoint, variables, features map[string]interface{}) (*http.Request, error) {
+	u, err := url.Parse(s.endpointURL(endpoint))
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"variables": variables,
+		"queryId":   path.Base(path.Dir(u.Path)),
+	}
+	if features != nil {
+		data["features"] = features
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// fetchUserTimeline of GraphQL endpoint for a given user
+func (s *Scraper) fetchUserTimeline(ctx context.Context, endpoint Endpoint, user string, count int, cursor string) (*graphQLTimeline, error) {
+	userID, err := s.GetUserIDByScreenNameWithContext(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := map[string]interface{}{
+		"userId":                 userID,
+		"count":                  count,
+		"includePromotedContent": false,
+		"withVoice":              true,
+		"withV2Timeline":         true,
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+	req, err := s.newGraphQLRequest(ctx, endpoint, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsn userTimeline
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := jsn.timeline()
+	if len(timeline.Instructions) == 0 {
+		if err := graphQLError(jsn.Errors); err != nil {
+			return nil, err
+		}
+	}
+	return timeline, nil
+}
+
+// graphQLError of response without data
+func graphQLError(errors []APIErrorDetail) error {
+	if len(errors) == 0 {
+		return nil
+	}
+	return &APIError{StatusCode: http.StatusOK, Status: "200 OK", Errors: errors}
+}
+
+// toTimeline convert GraphQL timeline to legacy timeline,
+// so tweets and users are parsed by parseTweets and parseUsers
+func (g *graphQLTimeline) toTimeline() *timeline {
+	var tl timeline
+	tl.GlobalObjects.Tweets = make(map[string]legacyTweet)
+	tl.GlobalObjects.Users = make(map[string]legacyUser)
+
+	var entries []timelineEntry
+	for _, instruction := range g.Instructions {
+		switch instruction.Type {
+		case "TimelinePinEntry":
+			var pin timelineInstruction
+			pin.PinEntry.Entry.Content.Item.Content.Tweet.ID = tl.addTweet(instruction.Entry.Content.ItemContent.TweetResults.Result)
+			tl.Timeline.Instructions = append(tl.Timeline.Instructions, pin)
+			continue
+		case "TimelineReplaceEntry":
+			instruction.Entries = append(instruction.Entries, instruction.Entry)
+		case "TimelineAddToModule":
+			var module graphQLEntry
+			module.Content.Items = instruction.ModuleItems
+			instruction.Entries = append(instruction.Entries, module)
+		}
+		for _, entry := range instruction.Entries {
+			if entry.Content.CursorType != "" {
+				var cursor timelineEntry
+				cursor.Content.Operation.Cursor.CursorType = entry.Content.CursorType
+				cursor.Content.Operation.Cursor.Value = entry.Content.Value
+				entries = append(entries, cursor)
+				continue
+			}
+			items := []graphQLItem{entry.Content.ItemContent}
+			for _, item := range entry.Content.Items {
+				items = append(items, item.Item.ItemContent)
+			}
+			for _, item := range items {
+				var e timelineEntry
+				e.Content.Item.Content.Tweet.ID = tl.addTweet(item.TweetResults.Result)
+				e.Content.Item.Content.Tweet.SocialContext = item.SocialContext
+				e.Content.Item.Content.User.ID = tl.addUser(item.UserResults.Result)
+				if e.Content.Item.Content.Tweet.ID != "" || e.Content.Item.Content.User.ID != "" {
+					entries = append(entries, e)
+				}
+			}
+		}
+	}
+
+	var instruction timelineInstruction
+	instruction.AddEntries.Entries = entries
+	tl.Timeline.Instructions = append(tl.Timeline.Instructions, instruction)
+	return &tl
+}
+
+// addUser of GraphQL result to global objects, returns user ID
+func (tl *timeline) addUser(user *userResult) string {
+	if user == nil || user.RestID == "" {
+		return ""
+	}
+	user.Legacy.IDStr = user.RestID
+	tl.GlobalObjects.Users[user.RestID] = user.Legacy
+	return user.RestID
+}
+
+// addTweet of GraphQL result with its author, quoted and retweeted tweets to global objects,
+// returns tweet ID
+func (tl *timeline) addTweet(tweet *tweetResult) string {
+	if tweet != nil && tweet.Tweet != nil {
+		tweet = tweet.Tweet
+	}
+	if tweet == nil || tweet.RestID == "" {
+		return ""
+	}
+	legacy := tweet.Legacy.legacyTweet
+	if userID := tl.addUser(tweet.Core.UserResults.Result); userID != "" {
+		legacy.UserIDStr = userID
+	}
+	if id := tl.addTweet(tweet.QuotedStatusResult.Result); id != "" {
+		legacy.QuotedStatusIDStr = id
+	}
+	if id := tl.addTweet(tweet.Legacy.RetweetedStatusResult.Result); id != "" {
+		legacy.RetweetedStatusIDStr = id
+	}
+	tl.GlobalObjects.Tweets[tweet.RestID] = legacy
+	return tweet.RestID
+}
+
+// parseTweetResult of GraphQL response, e.g. created tweet
+func parseTweetResult(result *tweetResult) *Tweet {
+	var tl timeline
+	tl.GlobalObjects.Tweets = make(map[string]legacyTweet)
+	tl.GlobalObjects.Users = make(map[string]legacyUser)
+	return tl.parseTweet(tl.addTweet(result))
+}
@@ -0,0 +1,309 @@
+package twitterscraper
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// guest token lifetime before refresh
+const guestTokenLifetime = 3 * time.Hour
+
+// GuestTokenPool keeps up to size guest tokens of each bearer token,
+// tracks their rate limit quota and rotates to the least used token per request.
+// Pool is safe for concurrent use and can be shared by several Scrapers.
+type GuestTokenPool struct {
+	mu      sync.Mutex
+	size    int
+	tokens  []*guestToken
+	refresh chan struct{}
+}
+
+type guestToken struct {
+	Token       string    `json:"token"`
+	BearerToken string    `json:"bearer_token"`
+	CreatedAt   time.Time `json:"created_at"`
+	// Remaining requests from X-Rate-Limit-Remaining, -1 if unknown
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+	Used      int       `json:"used"`
+}
+
+// NewGuestTokenPool with up to size tokens per bearer token
+func NewGuestTokenPool(size int) *GuestTokenPool {
+	if size < 1 {
+		size = 1
+	}
+	return &GuestTokenPool{
+		size:    size,
+		refresh: make(chan struct{}, 1),
+	}
+}
+
+// WithGuestTokenPool set pool of guest tokens used by requests
+func (s *Scraper) WithGuestTokenPool(pool *GuestTokenPool) *Scraper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.guestPool = pool
+	return s
+}
+
+func (s *Scraper) guestTokenPool() *GuestTokenPool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.guestPool
+}
+
+func (t *guestToken) expired(now time.Time) bool {
+	return t.CreatedAt.Before(now.Add(-guestTokenLifetime))
+}
+
+func (t *guestToken) exhausted(now time.Time) bool {
+	return t.Remaining == 0 && now.Before(t.Reset)
+}
+
+// quota of token, unknown quota is preferred over known
+func (t *guestToken) quota() int {
+	if t.Remaining < 0 {
+		return int(^uint(0) >> 1)
+	}
+	return t.Remaining
+}
+
+// Len of valid tokens in pool
+func (p *GuestTokenPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.prune(time.Now())
+	return len(p.tokens)
+}
+
+// has valid token of bearer token
+func (p *GuestTokenPool) has(bearer string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	p.prune(now)
+	for _, t := range p.tokens {
+		if t.BearerToken == bearer && !t.exhausted(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// prune expired tokens, caller must hold the lock
+func (p *GuestTokenPool) prune(now time.Time) {
+	tokens := p.tokens[:0]
+	for _, t := range p.tokens {
+		if !t.expired(now) {
+			tokens = append(tokens, t)
+		}
+	}
+	p.tokens = tokens
+}
+
+// pick least used token of bearer token, or report that a new token should be activated
+func (p *GuestTokenPool) pick(bearer string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	p.prune(now)
+
+	var best *guestToken
+	count := 0
+	for _, t := range p.tokens {
+		if t.BearerToken != bearer {
+			continue
+		}
+		count++
+		if t.exhausted(now) {
+			continue
+		}
+		if best == nil || t.quota() > best.quota() || (t.quota() == best.quota() && t.Used < best.Used) {
+			best = t
+		}
+	}
+	if count < p.size || best == nil {
+		return "", false
+	}
+	best.Used++
+	return best.Token, true
+}
+
+// add activated token, replacing an exhausted or the oldest token if pool is full
+func (p *GuestTokenPool) add(bearer, token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+
+	var same []int
+	for i, t := range p.tokens {
+		if t.BearerToken == bearer {
+			same = append(same, i)
+		}
+	}
+	if len(same) >= p.size {
+		evict := same[0]
+		for _, i := range same {
+			if p.tokens[i].exhausted(now) {
+				evict = i
+				break
+			}
+			if p.tokens[i].CreatedAt.Before(p.tokens[evict].CreatedAt) {
+				evict = i
+			}
+		}
+		p.tokens = append(p.tokens[:evict], p.tokens[evict+1:]...)
+	}
+	p.tokens = append(p.tokens, &guestToken{
+		Token:       token,
+		BearerToken: bearer,
+		CreatedAt:   now,
+		Remaining:   -1,
+		Used:        1,
+	})
+}
+
+// acquire guest token of bearer token, activating a new one if needed.
+// Concurrent callers wait for a single activation request.
+func (p *GuestTokenPool) acquire(ctx context.Context, bearer string, activate func(context.Context) (string, error)) (string, error) {
+	if token, ok := p.pick(bearer); ok {
+		return token, nil
+	}
+
+	select {
+	case p.refresh <- struct{}{}:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	defer func() { <-p.refresh }()
+
+	// token may be activated while waiting
+	if token, ok := p.pick(bearer); ok {
+		return token, nil
+	}
+	token, err := activate(ctx)
+	if err != nil {
+		return "", err
+	}
+	p.add(bearer, token)
+	return token, nil
+}
+
+// update rate limit quota of token from response headers
+func (p *GuestTokenPool) update(token string, resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining"))
+	if err != nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, t := range p.tokens {
+		if t.Token == token {
+			t.Remaining = remaining
+			t.Reset = rateLimitReset(resp)
+			if t.Reset.IsZero() && remaining == 0 {
+				t.Reset = time.Now().Add(RateLimitWindow)
+			}
+		}
+	}
+}
+
+// invalidate token rejected by API
+func (p *GuestTokenPool) invalidate(token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, t := range p.tokens {
+		if t.Token == token {
+			p.tokens = append(p.tokens[:i], p.tokens[i+1:]...)
+			return
+		}
+	}
+}
+
+// Save pool state as JSON
+func (p *GuestTokenPool) Save(w io.Writer) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.prune(time.Now())
+	return json.NewEncoder(w).Encode(p.tokens)
+}
+
+// Load pool state saved by Save, merged with tokens in pool.
+// Expired tokens are dropped, tokens already in pool keep their state
+// and only the newest size tokens of each bearer token are kept.
+func (p *GuestTokenPool) Load(r io.Reader) error {
+	var tokens []*guestToken
+	if err := json.NewDecoder(r).Decode(&tokens); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	known := make(map[string]bool, len(p.tokens))
+	for _, t := range p.tokens {
+		known[t.Token] = true
+	}
+	for _, t := range tokens {
+		if !known[t.Token] {
+			known[t.Token] = true
+			p.tokens = append(p.tokens, t)
+		}
+	}
+	p.prune(time.Now())
+	p.trim()
+	return nil
+}
+
+// trim tokens of each bearer token to pool size dropping the oldest, caller must hold the lock
+func (p *GuestTokenPool) trim() {
+	newest := make([]*guestToken, len(p.tokens))
+	copy(newest, p.tokens)
+	sort.SliceStable(newest, func(i, j int) bool {
+		return newest[i].CreatedAt.After(newest[j].CreatedAt)
+	})
+	keep := make(map[*guestToken]bool, len(newest))
+	count := make(map[string]int)
+	for _, t := range newest {
+		if count[t.BearerToken] < p.size {
+			count[t.BearerToken]++
+			keep[t] = true
+		}
+	}
+	tokens := p.tokens[:0]
+	for _, t := range p.tokens {
+		if keep[t] {
+			tokens = append(tokens, t)
+		}
+	}
+	p.tokens = tokens
+}
+
+// SaveFile write pool state to file
+func (p *GuestTokenPool) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := p.Save(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadFile read pool state from file written by SaveFile
+func (p *GuestTokenPool) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return p.Load(f)
+}
@@ -0,0 +1,100 @@
+package twitterscraper_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+func newPoolTestServer(tokens map[string]int) *httptest.Server {
+	var activations int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/1.1/guest/activate.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"guest_token":"token%d"}`, atomic.AddInt32(&activations, 1))
+	})
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("X-Guest-Token")
+		tokens[token]++
+		w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(180-tokens[token]))
+		fmt.Fprint(w, `{}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestGuestTokenPoolRotation(t *testing.T) {
+	tokens := make(map[string]int)
+	srv := newPoolTestServer(tokens)
+	defer srv.Close()
+
+	pool := twitterscraper.NewGuestTokenPool(3)
+	scraper := twitterscraper.New().
+		WithBaseURLs(twitterscraper.BaseURLs{API: srv.URL, Web: srv.URL}).
+		WithGuestTokenPool(pool)
+	for i := 0; i < 9; i++ {
+		if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if pool.Len() != 3 {
+		t.Errorf("Expected 3 tokens in pool, got %d", pool.Len())
+	}
+	for token, used := range tokens {
+		if used != 3 {
+			t.Errorf("Expected token %s used 3 times, got %d", token, used)
+		}
+	}
+}
+
+func TestGuestTokenPoolPersistence(t *testing.T) {
+	tokens := make(map[string]int)
+	srv := newPoolTestServer(tokens)
+	defer srv.Close()
+
+	pool := twitterscraper.NewGuestTokenPool(2)
+	scraper := twitterscraper.New().
+		WithBaseURLs(twitterscraper.BaseURLs{API: srv.URL, Web: srv.URL}).
+		WithGuestTokenPool(pool)
+	for i := 0; i < 2; i++ {
+		if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pool.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	saved := buf.Bytes()
+	restored := twitterscraper.NewGuestTokenPool(2)
+	// loading the same state twice keeps each token once
+	for i := 0; i < 2; i++ {
+		if err := restored.Load(bytes.NewReader(saved)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if restored.Len() != 2 {
+		t.Fatalf("Expected 2 restored tokens, got %d", restored.Len())
+	}
+	small := twitterscraper.NewGuestTokenPool(1)
+	if err := small.Load(bytes.NewReader(saved)); err != nil {
+		t.Fatal(err)
+	}
+	if small.Len() != 1 {
+		t.Errorf("Expected 1 token in pool of size 1, got %d", small.Len())
+	}
+
+	// restored tokens are reused instead of new activations
+	scraper.WithGuestTokenPool(restored)
+	if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 {
+		t.Errorf("Expected 2 tokens used, got %v", tokens)
+	}
+}
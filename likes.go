@@ -0,0 +1,39 @@
+package twitterscraper
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetLikes returns channel with tweets liked by a given user.
+func (s *Scraper) GetLikes(ctx context.Context, user string, maxTweetsNbr int) <-chan *TweetResult {
+	return getTweetTimeline(ctx, user, maxTweetsNbr, s.FetchLikesWithContext)
+}
+
+// FetchLikes gets tweets liked by a given user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchLikes(user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.FetchLikesWithContext(context.Background(), user, maxTweetsNbr, cursor)
+}
+
+// FetchLikesWithContext gets tweets liked by a given user, via the Twitter frontend GraphQL API.
+// Likes require cookie authentication, hidden likes return ErrProtected.
+func (s *Scraper) FetchLikesWithContext(ctx context.Context, user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, "", err
+	}
+
+	if maxTweetsNbr > 20 {
+		maxTweetsNbr = 20
+	}
+
+	timeline, err := s.fetchUserTimeline(ctx, EndpointLikes, user, maxTweetsNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(timeline.Instructions) == 0 {
+		return nil, "", fmt.Errorf("likes of @%s are hidden: %w", user, ErrProtected)
+	}
+
+	tweets, nextCursor := timeline.toTimeline().parseTweets()
+	return tweets, nextCursor, nil
+}
@@ -0,0 +1,80 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+// tweetResult of GraphQL timeline with author
+func tweetResult(id, text string) string {
+	return fmt.Sprintf(`{"__typename":"Tweet","rest_id":%[1]q,`+
+		`"core":{"user_results":{"result":{"__typename":"User","rest_id":"1","legacy":{"screen_name":"author"}}}},`+
+		`"legacy":{"full_text":%[2]q,"created_at":"Mon Jan 02 15:04:05 +0000 2006",`+
+		`"extended_entities":{"media":[{"type":"photo","media_url_https":"https://pbs.twimg.com/media/%[1]s.jpg"}]}}}`, id, text)
+}
+
+// tweetEntries of GraphQL timeline with tweets and bottom cursor
+func tweetEntries(cursor string, ids ...string) string {
+	var entries []string
+	for _, id := range ids {
+		entries = append(entries, fmt.Sprintf(`{"entryId":"tweet-%s","content":{"entryType":"TimelineTimelineItem",`+
+			`"itemContent":{"itemType":"TimelineTweet","tweet_results":{"result":%s}}}}`, id, tweetResult(id, "tweet "+id)))
+	}
+	entries = append(entries, fmt.Sprintf(`{"entryId":"cursor-bottom","content":{"entryType":"TimelineTimelineCursor","cursorType":"Bottom","value":%q}}`, cursor))
+	return `{"type":"TimelineAddEntries","entries":[` + strings.Join(entries, ",") + `]}`
+}
+
+func TestGetLikes(t *testing.T) {
+	mux := http.NewServeMux()
+	handleUserByScreenName(mux, "liking_user", "43")
+	mux.HandleFunc("/graphql/eSSNbhECHHWWALkkQq-YTA/Likes", func(w http.ResponseWriter, r *http.Request) {
+		var instruction string
+		if strings.Contains(r.URL.Query().Get("variables"), `"cursor":"page2"`) {
+			instruction = tweetEntries("page3")
+		} else {
+			instruction = tweetEntries("page2", "100", "101")
+		}
+		fmt.Fprintf(w, `{"data":{"user":{"result":{"__typename":"User","timeline_v2":{"timeline":{"instructions":[%s]}}}}}}`, instruction)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	var ids []string
+	for tweet := range scraper.GetLikes(context.Background(), "liking_user", 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if tweet.Username != "author" || tweet.Text != "tweet "+tweet.ID || len(tweet.Medias) != 1 {
+			t.Errorf("Unexpected tweet %+v", tweet.Tweet)
+		}
+		ids = append(ids, tweet.ID)
+	}
+	if strings.Join(ids, ",") != "100,101" {
+		t.Errorf("Expected 100,101, got %v", ids)
+	}
+}
+
+func TestGetLikesHidden(t *testing.T) {
+	mux := http.NewServeMux()
+	handleUserByScreenName(mux, "hiding_user", "44")
+	mux.HandleFunc("/graphql/eSSNbhECHHWWALkkQq-YTA/Likes", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"user":{"result":{"__typename":"User","timeline_v2":{}}}}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	if err := (<-scraper.GetLikes(context.Background(), "hiding_user", 10)).Error; !errors.Is(err, twitterscraper.ErrAuthRequired) {
+		t.Errorf("Expected ErrAuthRequired without cookies, got %v", err)
+	}
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+	if err := (<-scraper.GetLikes(context.Background(), "hiding_user", 10)).Error; !errors.Is(err, twitterscraper.ErrProtected) {
+		t.Errorf("Expected ErrProtected of hidden likes, got %v", err)
+	}
+}
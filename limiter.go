@@ -0,0 +1,154 @@
+package twitterscraper
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitWindow of Twitter API rate limits
+const RateLimitWindow = 15 * time.Minute
+
+// Limiter throttle API requests.
+// Implementations must be safe for concurrent use, one Limiter can be shared by several Scrapers.
+type Limiter interface {
+	// Wait blocks until request to endpoint is allowed or context is done.
+	// Endpoint is empty for requests to unregistered URLs.
+	Wait(ctx context.Context, endpoint Endpoint) error
+}
+
+// TokenBucket limiter allows bursts of requests and refills one token every interval
+type TokenBucket struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewTokenBucket with burst requests at once and one more every interval
+func NewTokenBucket(interval time.Duration, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		interval: interval,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+	}
+}
+
+// NewWindowLimiter allows requests per window, e.g. 180 requests per RateLimitWindow
+func NewWindowLimiter(requests int, window time.Duration) *TokenBucket {
+	if requests < 1 {
+		requests = 1
+	}
+	return NewTokenBucket(window/time.Duration(requests), requests)
+}
+
+// Wait reserves a token and blocks until it is available,
+// reservation is returned to the bucket if context is done first
+func (b *TokenBucket) Wait(ctx context.Context, _ Endpoint) error {
+	b.mu.Lock()
+	now := time.Now()
+	if b.interval <= 0 {
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+	if !b.last.IsZero() {
+		b.tokens += float64(now.Sub(b.last)) / float64(b.interval)
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens * float64(b.interval))
+	}
+	b.mu.Unlock()
+
+	if err := sleepContext(ctx, wait); err != nil {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// EndpointLimiter route requests to per-endpoint limiters
+type EndpointLimiter struct {
+	// Default limiter of endpoints without own budget, nil for no limit
+	Default Limiter
+	// Endpoints limiters
+	Endpoints map[Endpoint]Limiter
+}
+
+// NewEndpointLimiter with request budgets per RateLimitWindow for each endpoint
+func NewEndpointLimiter(budgets map[Endpoint]int, fallback Limiter) *EndpointLimiter {
+	l := &EndpointLimiter{
+		Default:   fallback,
+		Endpoints: make(map[Endpoint]Limiter, len(budgets)),
+	}
+	for endpoint, requests := range budgets {
+		l.Endpoints[endpoint] = NewWindowLimiter(requests, RateLimitWindow)
+	}
+	return l
+}
+
+// Wait on limiter of endpoint
+func (l *EndpointLimiter) Wait(ctx context.Context, endpoint Endpoint) error {
+	limiter, ok := l.Endpoints[endpoint]
+	if !ok {
+		limiter = l.Default
+	}
+	if limiter == nil {
+		return ctx.Err()
+	}
+	return limiter.Wait(ctx, endpoint)
+}
+
+// WithLimiter set limiter of API requests, nil disables throttling
+func (s *Scraper) WithLimiter(limiter Limiter) *Scraper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limiter = limiter
+	return s
+}
+
+// waitLimiter before API request, returns early if context is done
+func (s *Scraper) waitLimiter(ctx context.Context, rawURL string) error {
+	s.mu.RLock()
+	limiter := s.limiter
+	s.mu.RUnlock()
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx, s.endpointOf(rawURL))
+}
+
+// endpointOf URL, empty if it does not match any registered endpoint
+func (s *Scraper) endpointOf(rawURL string) Endpoint {
+	if i := strings.IndexByte(rawURL, '?'); i >= 0 {
+		rawURL = rawURL[:i]
+	}
+	for name := range defaultEndpoints {
+		// path argument matches a single path segment
+		pattern := strings.SplitN(s.endpointURL(name), "%s", 2)
+		if len(pattern) == 1 {
+			if rawURL == pattern[0] {
+				return name
+			}
+			continue
+		}
+		if strings.HasPrefix(rawURL, pattern[0]) && strings.HasSuffix(rawURL, pattern[1]) &&
+			len(rawURL) > len(pattern[0])+len(pattern[1]) &&
+			!strings.Contains(rawURL[len(pattern[0]):len(rawURL)-len(pattern[1])], "/") {
+			return name
+		}
+	}
+	return ""
+}
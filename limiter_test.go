@@ -0,0 +1,96 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := twitterscraper.NewTokenBucket(50*time.Millisecond, 2)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := bucket.Wait(context.Background(), ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// two requests of burst, then two more each 50ms
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond || elapsed > 500*time.Millisecond {
+		t.Errorf("Unexpected wait %s", elapsed)
+	}
+}
+
+func TestTokenBucketContext(t *testing.T) {
+	bucket := twitterscraper.NewTokenBucket(time.Hour, 1)
+	if err := bucket.Wait(context.Background(), ""); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := bucket.Wait(ctx, ""); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
+
+type recordLimiter struct {
+	mu        sync.Mutex
+	endpoints []twitterscraper.Endpoint
+}
+
+func (l *recordLimiter) Wait(ctx context.Context, endpoint twitterscraper.Endpoint) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.endpoints = append(l.endpoints, endpoint)
+	return nil
+}
+
+func TestEndpointLimiter(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/2/timeline/profile/783214.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	search, profile := &recordLimiter{}, &recordLimiter{}
+	limiter := &twitterscraper.EndpointLimiter{
+		Endpoints: map[twitterscraper.Endpoint]twitterscraper.Limiter{
+			twitterscraper.EndpointSearch:          search,
+			twitterscraper.EndpointProfileTimeline: profile,
+		},
+	}
+	scraper.WithLimiter(limiter)
+	// second Scraper shares the same limiter
+	other := twitterscraper.New().WithBaseURLs(twitterscraper.BaseURLs{API: srv.URL, Web: srv.URL}).WithLimiter(limiter)
+
+	if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := other.FetchSearchTweets("twitter", 20, ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(search.endpoints) != 2 {
+		t.Errorf("Expected 2 search requests, got %v", search.endpoints)
+	}
+
+	req, err := http.NewRequest("GET", srv.URL+"/2/timeline/profile/783214.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jsn interface{}
+	if err := scraper.RequestAPI(req, &jsn); err != nil {
+		t.Fatal(err)
+	}
+	if len(profile.endpoints) != 1 || profile.endpoints[0] != twitterscraper.EndpointProfileTimeline {
+		t.Errorf("Expected profile timeline request, got %v", profile.endpoints)
+	}
+}
@@ -0,0 +1,284 @@
+package twitterscraper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// List of Twitter users.
+type List struct {
+	Created         *time.Time
+	Description     string
+	ID              string
+	IsFollowing     bool
+	IsMember        bool
+	IsPrivate       bool
+	MemberCount     int
+	Name            string
+	Owner           *Profile
+	SubscriberCount int
+	URL             string
+}
+
+type legacyList struct {
+	IDStr           string `json:"id_str"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	MemberCount     int    `json:"member_count"`
+	SubscriberCount int    `json:"subscriber_count"`
+	Mode            string `json:"mode"`
+	// CreatedAt in milliseconds
+	CreatedAt   int64 `json:"created_at"`
+	Following   bool  `json:"following"`
+	IsMember    bool  `json:"is_member"`
+	UserResults struct {
+		Result *userResult `json:"result"`
+	} `json:"user_results"`
+}
+
+// restList JSON object of 1.1 API
+type restList struct {
+	IDStr           string     `json:"id_str"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	MemberCount     int        `json:"member_count"`
+	SubscriberCount int        `json:"subscriber_count"`
+	Mode            string     `json:"mode"`
+	CreatedAt       string     `json:"created_at"`
+	Following       bool       `json:"following"`
+	User            legacyUser `json:"user"`
+}
+
+type listTimeline struct {
+	Data struct {
+		List struct {
+			legacyList
+			TweetsTimeline struct {
+				Timeline graphQLTimeline `json:"timeline"`
+			} `json:"tweets_timeline"`
+			MembersTimeline struct {
+				Timeline graphQLTimeline `json:"timeline"`
+			} `json:"members_timeline"`
+			SubscribersTimeline struct {
+				Timeline graphQLTimeline `json:"timeline"`
+			} `json:"subscribers_timeline"`
+		} `json:"list"`
+	} `json:"data"`
+	Errors []APIErrorDetail `json:"errors"`
+}
+
+// empty if response has neither list nor its timelines
+func (t *listTimeline) empty() bool {
+	list := &t.Data.List
+	return list.IDStr == "" && len(list.TweetsTimeline.Timeline.Instructions) == 0 &&
+		len(list.MembersTimeline.Timeline.Instructions) == 0 && len(list.SubscribersTimeline.Timeline.Instructions) == 0
+}
+
+func parseList(list legacyList) List {
+	l := List{
+		Description:     list.Description,
+		ID:              list.IDStr,
+		IsFollowing:     list.Following,
+		IsMember:        list.IsMember,
+		IsPrivate:       strings.EqualFold(list.Mode, "private"),
+		MemberCount:     list.MemberCount,
+		Name:            list.Name,
+		SubscriberCount: list.SubscriberCount,
+		URL:             "https://twitter.com/i/lists/" + list.IDStr,
+	}
+	if list.CreatedAt > 0 {
+		tm := time.Unix(0, list.CreatedAt*int64(time.Millisecond)).UTC()
+		l.Created = &tm
+	}
+	if owner := list.UserResults.Result; owner != nil && owner.RestID != "" {
+		owner.Legacy.IDStr = owner.RestID
+		profile := parseProfile(owner.Legacy)
+		l.Owner = &profile
+	}
+	return l
+}
+
+func parseRestList(list restList) List {
+	l := parseList(legacyList{
+		IDStr:           list.IDStr,
+		Name:            list.Name,
+		Description:     list.Description,
+		MemberCount:     list.MemberCount,
+		SubscriberCount: list.SubscriberCount,
+		Mode:            list.Mode,
+		Following:       list.Following,
+	})
+	if tm, err := time.Parse(time.RubyDate, list.CreatedAt); err == nil {
+		tm = tm.UTC()
+		l.Created = &tm
+	}
+	if list.User.IDStr != "" {
+		profile := parseProfile(list.User)
+		l.Owner = &profile
+	}
+	return l
+}
+
+// parseLists of timeline with list items
+func (g *graphQLTimeline) parseLists() ([]*List, string) {
+	var cursor string
+	var lists []*List
+	for _, instruction := range g.Instructions {
+		for _, entry := range instruction.Entries {
+			if entry.Content.CursorType == "Bottom" {
+				cursor = entry.Content.Value
+			}
+			items := []graphQLItem{entry.Content.ItemContent}
+			for _, item := range entry.Content.Items {
+				items = append(items, item.Item.ItemContent)
+			}
+			for _, item := range items {
+				if item.List != nil && item.List.IDStr != "" {
+					list := parseList(*item.List)
+					lists = append(lists, &list)
+				}
+			}
+		}
+	}
+	return lists, cursor
+}
+
+// GetList return list by ID.
+func (s *Scraper) GetList(id string) (List, error) {
+	return s.GetListWithContext(context.Background(), id)
+}
+
+// GetListWithContext return list by ID.
+func (s *Scraper) GetListWithContext(ctx context.Context, id string) (List, error) {
+	jsn, err := s.fetchListTimeline(ctx, EndpointListByRestID, id, 0, "")
+	if err != nil {
+		return List{}, err
+	}
+	if jsn.Data.List.IDStr == "" {
+		return List{}, fmt.Errorf("list %s not found: %w", id, ErrNotFound)
+	}
+	return parseList(jsn.Data.List.legacyList), nil
+}
+
+// GetListTweets returns channel with tweets of list members.
+func (s *Scraper) GetListTweets(ctx context.Context, id string, maxTweetsNbr int) <-chan *TweetResult {
+	return getTweetTimeline(ctx, id, maxTweetsNbr, s.FetchListTweetsWithContext)
+}
+
+// GetListMembers returns channel with profiles of list members.
+func (s *Scraper) GetListMembers(ctx context.Context, id string, maxProfilesNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, id, maxProfilesNbr, s.FetchListMembersWithContext)
+}
+
+// GetListSubscribers returns channel with profiles of list subscribers.
+func (s *Scraper) GetListSubscribers(ctx context.Context, id string, maxProfilesNbr int) <-chan *ProfileResult {
+	return getUserTimeline(ctx, id, maxProfilesNbr, s.FetchListSubscribersWithContext)
+}
+
+// GetUserListMemberships returns channel with lists a given user is member of.
+func (s *Scraper) GetUserListMemberships(ctx context.Context, user string, maxListsNbr int) <-chan *ListResult {
+	return getListTimeline(ctx, user, maxListsNbr, s.FetchUserListMembershipsWithContext)
+}
+
+// FetchListTweets gets tweets of list members, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListTweets(id string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.FetchListTweetsWithContext(context.Background(), id, maxTweetsNbr, cursor)
+}
+
+// FetchListTweetsWithContext gets tweets of list members, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListTweetsWithContext(ctx context.Context, id string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if maxTweetsNbr > 20 {
+		maxTweetsNbr = 20
+	}
+	jsn, err := s.fetchListTimeline(ctx, EndpointListTweets, id, maxTweetsNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	tweets, nextCursor := jsn.Data.List.TweetsTimeline.Timeline.toTimeline().parseTweets()
+	return tweets, nextCursor, nil
+}
+
+// FetchListMembers gets profiles of list members, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListMembers(id string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.FetchListMembersWithContext(context.Background(), id, maxProfilesNbr, cursor)
+}
+
+// FetchListMembersWithContext gets profiles of list members, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListMembersWithContext(ctx context.Context, id string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	if maxProfilesNbr > 50 {
+		maxProfilesNbr = 50
+	}
+	jsn, err := s.fetchListTimeline(ctx, EndpointListMembers, id, maxProfilesNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	users, nextCursor := jsn.Data.List.MembersTimeline.Timeline.toTimeline().parseUsers()
+	return users, nextCursor, nil
+}
+
+// FetchListSubscribers gets profiles of list subscribers, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListSubscribers(id string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	return s.FetchListSubscribersWithContext(context.Background(), id, maxProfilesNbr, cursor)
+}
+
+// FetchListSubscribersWithContext gets profiles of list subscribers, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchListSubscribersWithContext(ctx context.Context, id string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	if maxProfilesNbr > 50 {
+		maxProfilesNbr = 50
+	}
+	jsn, err := s.fetchListTimeline(ctx, EndpointListSubscribers, id, maxProfilesNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	users, nextCursor := jsn.Data.List.SubscribersTimeline.Timeline.toTimeline().parseUsers()
+	return users, nextCursor, nil
+}
+
+// FetchUserListMemberships gets lists a given user is member of, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchUserListMemberships(user string, maxListsNbr int, cursor string) ([]*List, string, error) {
+	return s.FetchUserListMembershipsWithContext(context.Background(), user, maxListsNbr, cursor)
+}
+
+// FetchUserListMembershipsWithContext gets lists a given user is member of, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchUserListMembershipsWithContext(ctx context.Context, user string, maxListsNbr int, cursor string) ([]*List, string, error) {
+	if maxListsNbr > 50 {
+		maxListsNbr = 50
+	}
+	timeline, err := s.fetchUserTimeline(ctx, EndpointListMemberships, user, maxListsNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	lists, nextCursor := timeline.parseLists()
+	return lists, nextCursor, nil
+}
+
+// fetchListTimeline of GraphQL endpoint for a given list, count is omitted if zero
+func (s *Scraper) fetchListTimeline(ctx context.Context, endpoint Endpoint, id string, count int, cursor string) (*listTimeline, error) {
+	variables := map[string]interface{}{
+		"listId": id,
+	}
+	if count > 0 {
+		variables["count"] = count
+	}
+	if cursor != "" {
+		variables["cursor"] = cursor
+	}
+	req, err := s.newGraphQLRequest(ctx, endpoint, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsn listTimeline
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+	if jsn.empty() {
+		if err := graphQLError(jsn.Errors); err != nil {
+			return nil, err
+		}
+	}
+	return &jsn, nil
+}
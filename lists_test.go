@@ -0,0 +1,175 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+const testList = `{"id_str":"500","name":"sources","description":"curated","member_count":2,"subscriber_count":1,` +
+	`"mode":"Private","created_at":1600000000000,"following":true,` +
+	`"user_results":{"result":{"__typename":"User","rest_id":"10","legacy":{"screen_name":"alice"}}}}`
+
+func newListMux(t *testing.T) *http.ServeMux {
+	mux := http.NewServeMux()
+	handleUserByScreenName(mux, "list_member", "46")
+	mux.HandleFunc("/graphql/wXzyA5vM_aVkBL9G8Vp3kw/ListByRestId", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Query().Get("variables"), `"listId":"500"`) {
+			fmt.Fprint(w, `{"data":{}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"list":%s}}`, testList)
+	})
+	handleListTimeline := func(path, timeline, first string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			variables := r.URL.Query().Get("variables")
+			if !strings.Contains(variables, `"listId":"500"`) {
+				t.Errorf("Expected listId 500, got %s", variables)
+			}
+			instruction := first
+			if strings.Contains(variables, `"cursor":"page2"`) {
+				instruction = userEntries("page3")
+			}
+			fmt.Fprintf(w, `{"data":{"list":{%q:{"timeline":{"instructions":[%s]}}}}}`, timeline, instruction)
+		})
+	}
+	handleListTimeline("/graphql/2TemLyqrMpTeAmysdbnVqw/ListLatestTweetsTimeline", "tweets_timeline", tweetEntries("page2", "600", "601"))
+	handleListTimeline("/graphql/BQp2IEYkgxuSxqbTAr1e1g/ListMembers", "members_timeline", userEntries("page2", "alice", "bob"))
+	handleListTimeline("/graphql/74wGEkaBxrdoXakWTWMxRQ/ListSubscribers", "subscribers_timeline", userEntries("page2", "carol"))
+	mux.HandleFunc("/graphql/BlEXXdARdSeL_0KyKHHvvg/ListMemberships", func(w http.ResponseWriter, r *http.Request) {
+		var entries string
+		if !strings.Contains(r.URL.Query().Get("variables"), `"cursor"`) {
+			entries = fmt.Sprintf(`{"entryId":"list-500","content":{"entryType":"TimelineTimelineItem",`+
+				`"itemContent":{"itemType":"TimelineTwitterList","list":%s}}},`, testList)
+		}
+		entries += `{"entryId":"cursor-bottom","content":{"entryType":"TimelineTimelineCursor","cursorType":"Bottom","value":"page2"}}`
+		fmt.Fprintf(w, `{"data":{"user":{"result":{"__typename":"User","timeline":{"timeline":{"instructions":[`+
+			`{"type":"TimelineAddEntries","entries":[%s]}]}}}}}}`, entries)
+	})
+	return mux
+}
+
+func TestGetList(t *testing.T) {
+	srv, scraper := newTestServer(newListMux(t))
+	defer srv.Close()
+
+	list, err := scraper.GetList("500")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Name != "sources" || !list.IsPrivate || list.MemberCount != 2 || list.Owner == nil || list.Owner.Username != "alice" {
+		t.Errorf("Unexpected list %+v", list)
+	}
+	if list.Created == nil || list.Created.Unix() != 1600000000 {
+		t.Errorf("Unexpected list creation time %v", list.Created)
+	}
+	if _, err := scraper.GetList("404"); !errors.Is(err, twitterscraper.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestGetListTimelines(t *testing.T) {
+	srv, scraper := newTestServer(newListMux(t))
+	defer srv.Close()
+	ctx := context.Background()
+
+	var ids []string
+	for tweet := range scraper.GetListTweets(ctx, "500", 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		ids = append(ids, tweet.ID)
+	}
+	if strings.Join(ids, ",") != "600,601" {
+		t.Errorf("Expected list tweets 600,601, got %v", ids)
+	}
+
+	for _, test := range []struct {
+		profiles <-chan *twitterscraper.ProfileResult
+		expected string
+	}{
+		{scraper.GetListMembers(ctx, "500", 10), "alice,bob"},
+		{scraper.GetListSubscribers(ctx, "500", 10), "carol"},
+	} {
+		var names []string
+		for profile := range test.profiles {
+			if profile.Error != nil {
+				t.Fatal(profile.Error)
+			}
+			names = append(names, profile.Username)
+		}
+		if strings.Join(names, ",") != test.expected {
+			t.Errorf("Expected %s, got %v", test.expected, names)
+		}
+	}
+
+	var lists []string
+	for list := range scraper.GetUserListMemberships(ctx, "list_member", 10) {
+		if list.Error != nil {
+			t.Fatal(list.Error)
+		}
+		lists = append(lists, list.ID)
+	}
+	if strings.Join(lists, ",") != "500" {
+		t.Errorf("Expected list memberships 500, got %v", lists)
+	}
+}
+
+func TestListManagement(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	handleUserByScreenName(mux, "new_member", "47")
+	for _, path := range []string{"create", "update", "destroy", "members/create", "members/destroy", "subscribers/create"} {
+		path := path
+		mux.HandleFunc("/i/api/1.1/lists/"+path+".json", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "POST" || r.Header.Get("x-csrf-token") != "csrf" {
+				t.Errorf("Expected authenticated POST, got %s with csrf %q", r.Method, r.Header.Get("x-csrf-token"))
+			}
+			q := r.URL.Query()
+			requests = append(requests, fmt.Sprintf("%s:%s:%s:%s:%s", path, q.Get("list_id"), q.Get("user_id"), q.Get("name"), q.Get("mode")))
+			fmt.Fprint(w, `{"id_str":"700","name":"monitor","mode":"private","member_count":1,`+
+				`"created_at":"Mon Jan 02 15:04:05 +0000 2006","user":{"id_str":"10","screen_name":"alice"}}`)
+		})
+	}
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	if _, err := scraper.CreateList(twitterscraper.ListParams{Name: "monitor"}); !errors.Is(err, twitterscraper.ErrAuthRequired) {
+		t.Errorf("Expected ErrAuthRequired without cookies, got %v", err)
+	}
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	list, err := scraper.CreateList(twitterscraper.ListParams{Name: "monitor", Mode: twitterscraper.ListPrivate})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.ID != "700" || !list.IsPrivate || list.Owner.Username != "alice" || list.Created.Year() != 2006 {
+		t.Errorf("Unexpected list %+v", list)
+	}
+	for _, op := range []func() (*twitterscraper.List, error){
+		func() (*twitterscraper.List, error) {
+			return scraper.UpdateList("700", twitterscraper.ListParams{Name: "renamed"})
+		},
+		func() (*twitterscraper.List, error) {
+			return scraper.UpdateList("700", twitterscraper.ListParams{Mode: twitterscraper.ListPublic})
+		},
+		func() (*twitterscraper.List, error) { return scraper.AddListMember("700", "new_member") },
+		func() (*twitterscraper.List, error) { return scraper.RemoveListMember("700", "new_member") },
+		func() (*twitterscraper.List, error) { return scraper.SubscribeList("700") },
+		func() (*twitterscraper.List, error) { return scraper.DeleteList("700") },
+	} {
+		if _, err := op(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := "create:::monitor:private,update:700::renamed:,update:700:::public,members/create:700:47::," +
+		"members/destroy:700:47::,subscribers/create:700:::,destroy:700:::"
+	if strings.Join(requests, ",") != expected {
+		t.Errorf("Unexpected requests %v", requests)
+	}
+}
@@ -0,0 +1,214 @@
+package twitterscraper
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// NotificationKind of notification
+type NotificationKind string
+
+const (
+	// NotificationLike - actors liked tweet
+	NotificationLike NotificationKind = "like"
+	// NotificationRetweet - actors retweeted tweet
+	NotificationRetweet NotificationKind = "retweet"
+	// NotificationFollow - actors followed authenticated user
+	NotificationFollow NotificationKind = "follow"
+	// NotificationMention - actor mentioned authenticated user in tweet
+	NotificationMention NotificationKind = "mention"
+	// NotificationReply - actor replied with tweet
+	NotificationReply NotificationKind = "reply"
+	// NotificationOther - other notifications, e.g. recommendations
+	NotificationOther NotificationKind = "other"
+)
+
+// Notification of authenticated user.
+type Notification struct {
+	ID      string
+	Kind    NotificationKind
+	Message string
+	Actors  []*Profile
+	// Tweet target of like or retweet, or tweet of mention or reply
+	Tweet      *Tweet
+	TimeParsed time.Time
+	Timestamp  int64
+}
+
+// legacyNotification of timeline globalObjects
+type legacyNotification struct {
+	ID          string `json:"id"`
+	TimestampMs string `json:"timestampMs"`
+	Icon        struct {
+		ID string `json:"id"`
+	} `json:"icon"`
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Template struct {
+		AggregateUserActionsV1 struct {
+			TargetObjects []struct {
+				Tweet struct {
+					ID string `json:"id"`
+				} `json:"tweet"`
+			} `json:"targetObjects"`
+			FromUsers []struct {
+				User struct {
+					ID string `json:"id"`
+				} `json:"user"`
+			} `json:"fromUsers"`
+		} `json:"aggregateUserActionsV1"`
+	} `json:"template"`
+}
+
+// GetNotifications returns channel with notifications of authenticated user.
+func (s *Scraper) GetNotifications(ctx context.Context, maxNotificationsNbr int) <-chan *NotificationResult {
+	return getNotificationTimeline(ctx, "", maxNotificationsNbr, s.FetchNotificationsWithContext)
+}
+
+// GetMentions returns channel with mentions and replies of authenticated user.
+func (s *Scraper) GetMentions(ctx context.Context, maxNotificationsNbr int) <-chan *NotificationResult {
+	return getNotificationTimeline(ctx, "", maxNotificationsNbr, s.FetchMentionsWithContext)
+}
+
+// FetchNotifications gets notifications of authenticated user, via the Twitter frontend API.
+func (s *Scraper) FetchNotifications(_ string, maxNotificationsNbr int, cursor string) ([]*Notification, string, error) {
+	return s.FetchNotificationsWithContext(context.Background(), "", maxNotificationsNbr, cursor)
+}
+
+// FetchNotificationsWithContext gets notifications of authenticated user, via the Twitter frontend API.
+func (s *Scraper) FetchNotificationsWithContext(ctx context.Context, _ string, maxNotificationsNbr int, cursor string) ([]*Notification, string, error) {
+	return s.fetchNotifications(ctx, EndpointNotifications, maxNotificationsNbr, cursor)
+}
+
+// FetchMentions gets mentions and replies of authenticated user, via the Twitter frontend API.
+func (s *Scraper) FetchMentions(_ string, maxNotificationsNbr int, cursor string) ([]*Notification, string, error) {
+	return s.FetchMentionsWithContext(context.Background(), "", maxNotificationsNbr, cursor)
+}
+
+// FetchMentionsWithContext gets mentions and replies of authenticated user, via the Twitter frontend API.
+func (s *Scraper) FetchMentionsWithContext(ctx context.Context, _ string, maxNotificationsNbr int, cursor string) ([]*Notification, string, error) {
+	return s.fetchNotifications(ctx, EndpointMentions, maxNotificationsNbr, cursor)
+}
+
+func (s *Scraper) fetchNotifications(ctx context.Context, endpoint Endpoint, maxNotificationsNbr int, cursor string) ([]*Notification, string, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, "", err
+	}
+
+	if maxNotificationsNbr > 40 {
+		maxNotificationsNbr = 40
+	}
+
+	req, err := s.newRequest(ctx, "GET", s.endpointURL(endpoint))
+	if err != nil {
+		return nil, "", err
+	}
+
+	q := req.URL.Query()
+	q.Add("count", strconv.Itoa(maxNotificationsNbr))
+	if cursor != "" {
+		q.Add("cursor", cursor)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	var timeline timeline
+	err = s.RequestAPI(req, &timeline)
+	if err != nil {
+		return nil, "", err
+	}
+
+	notifications, nextCursor := timeline.parseNotifications()
+	return notifications, nextCursor, nil
+}
+
+func (timeline *timeline) parseNotifications() ([]*Notification, string) {
+	var cursor string
+	var notifications []*Notification
+	for _, instruction := range timeline.Timeline.Instructions {
+		for _, entry := range instruction.AddEntries.Entries {
+			content := entry.Content.Item.Content
+			if notification := timeline.parseNotification(content.Notification.ID); notification != nil {
+				notifications = append(notifications, notification)
+			}
+			if notification := timeline.parseTweetNotification(content.Tweet.ID); notification != nil {
+				notifications = append(notifications, notification)
+			}
+			if entry.Content.Operation.Cursor.CursorType == "Bottom" {
+				cursor = entry.Content.Operation.Cursor.Value
+			}
+		}
+		if instruction.ReplaceEntry.Entry.Content.Operation.Cursor.CursorType == "Bottom" {
+			cursor = instruction.ReplaceEntry.Entry.Content.Operation.Cursor.Value
+		}
+	}
+	return notifications, cursor
+}
+
+// parseNotification of likes, retweets and follows aggregated by Twitter
+func (timeline *timeline) parseNotification(id string) *Notification {
+	n, ok := timeline.GlobalObjects.Notifications[id]
+	if !ok {
+		return nil
+	}
+
+	notification := &Notification{
+		ID:      id,
+		Message: n.Message.Text,
+	}
+	switch n.Icon.ID {
+	case "heart_icon":
+		notification.Kind = NotificationLike
+	case "retweet_icon":
+		notification.Kind = NotificationRetweet
+	case "person_icon":
+		notification.Kind = NotificationFollow
+	default:
+		notification.Kind = NotificationOther
+	}
+	if ms, err := strconv.ParseInt(n.TimestampMs, 10, 64); err == nil {
+		notification.TimeParsed = time.Unix(0, ms*int64(time.Millisecond)).UTC()
+		notification.Timestamp = notification.TimeParsed.Unix()
+	}
+
+	actions := n.Template.AggregateUserActionsV1
+	for _, from := range actions.FromUsers {
+		if user, ok := timeline.GlobalObjects.Users[from.User.ID]; ok {
+			profile := parseProfile(user)
+			notification.Actors = append(notification.Actors, &profile)
+		}
+	}
+	for _, target := range actions.TargetObjects {
+		if tweet := timeline.parseTweet(target.Tweet.ID); tweet != nil {
+			notification.Tweet = tweet
+			break
+		}
+	}
+	return notification
+}
+
+// parseTweetNotification of mention or reply tweet
+func (timeline *timeline) parseTweetNotification(id string) *Notification {
+	tweet := timeline.parseTweet(id)
+	if tweet == nil {
+		return nil
+	}
+
+	notification := &Notification{
+		ID:         id,
+		Kind:       NotificationMention,
+		Message:    tweet.Text,
+		Tweet:      tweet,
+		TimeParsed: tweet.TimeParsed,
+		Timestamp:  tweet.Timestamp,
+	}
+	if tweet.IsReply {
+		notification.Kind = NotificationReply
+	}
+	if user, ok := timeline.GlobalObjects.Users[tweet.UserID]; ok {
+		profile := parseProfile(user)
+		notification.Actors = []*Profile{&profile}
+	}
+	return notification
+}
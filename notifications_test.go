@@ -0,0 +1,95 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+const notificationObjects = `"tweets":{` +
+	`"1":{"id_str":"1","full_text":"my tweet","user_id_str":"10"},` +
+	`"2":{"id_str":"2","full_text":"@alice hi","user_id_str":"20"},` +
+	`"3":{"id_str":"3","full_text":"@alice agreed","user_id_str":"20","in_reply_to_status_id_str":"1"}},` +
+	`"users":{"10":{"id_str":"10","screen_name":"alice"},"20":{"id_str":"20","screen_name":"bob"},"30":{"id_str":"30","screen_name":"carol"}}`
+
+func TestGetNotifications(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/notifications/all.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "page2" {
+			fmt.Fprint(w, `{"globalObjects":{},"timeline":{"instructions":[{"addEntries":{"entries":[]}}]}}`)
+			return
+		}
+		fmt.Fprint(w, `{"globalObjects":{`+notificationObjects+`,"notifications":{`+
+			`"n1":{"id":"n1","timestampMs":"1600000000000","icon":{"id":"heart_icon"},"message":{"text":"bob and carol liked your Tweet"},`+
+			`"template":{"aggregateUserActionsV1":{"targetObjects":[{"tweet":{"id":"1"}}],"fromUsers":[{"user":{"id":"20"}},{"user":{"id":"30"}}]}}},`+
+			`"n2":{"id":"n2","timestampMs":"1600000001000","icon":{"id":"person_icon"},"message":{"text":"carol followed you"},`+
+			`"template":{"aggregateUserActionsV1":{"fromUsers":[{"user":{"id":"30"}}]}}}}},`+
+			`"timeline":{"instructions":[{"addEntries":{"entries":[`+
+			`{"entryId":"notification-n1","content":{"item":{"content":{"notification":{"id":"n1"}}}}},`+
+			`{"entryId":"notification-2","content":{"item":{"content":{"tweet":{"id":"2"}}}}},`+
+			`{"entryId":"notification-n2","content":{"item":{"content":{"notification":{"id":"n2"}}}}},`+
+			`{"entryId":"cursor-bottom","content":{"operation":{"cursor":{"value":"page2","cursorType":"Bottom"}}}}]}}]}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	for n := range scraper.GetNotifications(context.Background(), 10) {
+		if !errors.Is(n.Error, twitterscraper.ErrAuthRequired) {
+			t.Errorf("Expected ErrAuthRequired without cookies, got %v", n.Error)
+		}
+	}
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	var notifications []string
+	for n := range scraper.GetNotifications(context.Background(), 10) {
+		if n.Error != nil {
+			t.Fatal(n.Error)
+		}
+		var actors []string
+		for _, actor := range n.Actors {
+			actors = append(actors, actor.Username)
+		}
+		var tweet string
+		if n.Tweet != nil {
+			tweet = n.Tweet.ID
+		}
+		notifications = append(notifications, fmt.Sprintf("%s:%s:%s:%d", n.Kind, strings.Join(actors, "+"), tweet, n.Timestamp))
+	}
+	expected := "like:bob+carol:1:1600000000,mention:bob:2:0,follow:carol::1600000001"
+	if strings.Join(notifications, ",") != expected {
+		t.Errorf("Unexpected notifications %v", notifications)
+	}
+}
+
+func TestGetMentions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/notifications/mentions.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "page2" {
+			fmt.Fprint(w, `{"globalObjects":{},"timeline":{"instructions":[]}}`)
+			return
+		}
+		fmt.Fprint(w, `{"globalObjects":{`+notificationObjects+`},"timeline":{"instructions":[{"addEntries":{"entries":[`+
+			`{"entryId":"tweet-3","content":{"item":{"content":{"tweet":{"id":"3"}}}}},`+
+			`{"entryId":"tweet-2","content":{"item":{"content":{"tweet":{"id":"2"}}}}},`+
+			`{"entryId":"cursor-bottom","content":{"operation":{"cursor":{"value":"page2","cursorType":"Bottom"}}}}]}}]}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	var mentions []string
+	for n := range scraper.GetMentions(context.Background(), 10) {
+		if n.Error != nil {
+			t.Fatal(n.Error)
+		}
+		mentions = append(mentions, fmt.Sprintf("%s:%s:%s", n.Kind, n.Actors[0].Username, n.Tweet.Text))
+	}
+	if strings.Join(mentions, ",") != "reply:bob:@alice agreed,mention:bob:@alice hi" {
+		t.Errorf("Unexpected mentions %v", mentions)
+	}
+}
@@ -1,6 +1,8 @@
 package twitterscraper
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"sync"
@@ -42,18 +44,32 @@ type user struct {
 			Legacy legacyUser `json:"legacy"`
 		} `json:"user"`
 	} `json:"data"`
-	Errors []struct {
-		Message string `json:"message"`
-	} `json:"errors"`
+	Errors []APIErrorDetail `json:"errors"`
 }
 
 // GetProfile return parsed user profile.
 func (s *Scraper) GetProfile(username string) (Profile, error) {
+	return s.GetProfileWithContext(context.Background(), username)
+}
+
+// GetProfileWithContext return parsed user profile.
+func (s *Scraper) GetProfileWithContext(ctx context.Context, username string) (Profile, error) {
 	var jsn user
-	req, err := http.NewRequest("GET", "https://api.twitter.com/graphql/4S2ihIKfF3xhp-ENxvUAfQ/UserByScreenName?variables=%7B%22screen_name%22%3A%22"+username+"%22%2C%22withHighlightedLabel%22%3Atrue%7D", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", s.endpointURL(EndpointUserByScreenName), nil)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	variables, err := json.Marshal(map[string]interface{}{
+		"screen_name":          username,
+		"withHighlightedLabel": true,
+	})
 	if err != nil {
 		return Profile{}, err
 	}
+	q := req.URL.Query()
+	q.Add("variables", string(variables))
+	req.URL.RawQuery = q.Encode()
 
 	err = s.RequestAPI(req, &jsn)
 	if err != nil {
@@ -61,16 +77,16 @@ func (s *Scraper) GetProfile(username string) (Profile, error) {
 	}
 
 	if len(jsn.Errors) > 0 {
-		return Profile{}, fmt.Errorf("%s", jsn.Errors[0].Message)
+		return Profile{}, &APIError{StatusCode: http.StatusOK, Status: "200 OK", Errors: jsn.Errors}
 	}
 
 	if jsn.Data.User.RestID == "" {
-		return Profile{}, fmt.Errorf("rest_id not found")
+		return Profile{}, fmt.Errorf("rest_id of @%s not found: %w", username, ErrNotFound)
 	}
 	jsn.Data.User.Legacy.IDStr = jsn.Data.User.RestID
 
 	if jsn.Data.User.Legacy.ScreenName == "" {
-		return Profile{}, fmt.Errorf("either @%s does not exist or is private", username)
+		return Profile{}, fmt.Errorf("either @%s does not exist or is private: %w", username, ErrProtected)
 	}
 
 	return parseProfile(jsn.Data.User.Legacy), nil
@@ -83,12 +99,17 @@ func GetProfile(username string) (Profile, error) {
 
 // GetUserIDByScreenName from API
 func (s *Scraper) GetUserIDByScreenName(screenName string) (string, error) {
+	return s.GetUserIDByScreenNameWithContext(context.Background(), screenName)
+}
+
+// GetUserIDByScreenNameWithContext from API
+func (s *Scraper) GetUserIDByScreenNameWithContext(ctx context.Context, screenName string) (string, error) {
 	id, ok := cacheIDs.Load(screenName)
 	if ok {
 		return id.(string), nil
 	}
 
-	profile, err := s.GetProfile(screenName)
+	profile, err := s.GetProfileWithContext(ctx, screenName)
 	if err != nil {
 		return "", err
 	}
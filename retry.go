@@ -0,0 +1,115 @@
+package twitterscraper
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy of failed API requests
+type RetryPolicy struct {
+	// MaxAttempts of a request, 1 disables retries
+	MaxAttempts int
+	// MinBackoff before the first retry, doubled on each next attempt
+	MinBackoff time.Duration
+	// MaxBackoff between two attempts
+	MaxBackoff time.Duration
+	// MaxWait for Retry-After or rate limit reset, longer waits abort the request
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy used by New
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Second,
+	MaxBackoff:  30 * time.Second,
+	MaxWait:     15 * time.Minute,
+}
+
+// WithRetry set retry policy of API requests
+func (s *Scraper) WithRetry(policy RetryPolicy) *Scraper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retryPolicy = policy
+	return s
+}
+
+// backoff before attempt, with full jitter over the second half of the interval
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 1 {
+		return d
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
+// idempotent requests may be sent again, retried POST could post a tweet twice
+func idempotent(req *http.Request) bool {
+	return req.Method == "" || req.Method == http.MethodGet || req.Method == http.MethodHead
+}
+
+// retryable status codes, guest token is refreshed before retrying 401 and 429
+func retryable(resp *http.Response) bool {
+	if resp == nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusTooManyRequests:
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter returns server requested wait from Retry-After header,
+// or from X-Rate-Limit-Reset if rate limit does not go away with a new guest token
+func retryAfter(resp *http.Response, authenticated bool) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if tm, err := http.ParseTime(value); err == nil {
+			return time.Until(tm)
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests && authenticated {
+		if reset := rateLimitReset(resp); !reset.IsZero() {
+			return time.Until(reset)
+		}
+	}
+	return 0
+}
+
+// rateLimitReset time from X-Rate-Limit-Reset header, zero if not set
+func rateLimitReset(resp *http.Response) time.Time {
+	reset, err := strconv.ParseInt(resp.Header.Get("X-Rate-Limit-Reset"), 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(reset, 0)
+}
+
+// sleepContext pause until duration passed or context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
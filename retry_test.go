@@ -0,0 +1,124 @@
+package twitterscraper_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+var testRetryPolicy = twitterscraper.RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  10 * time.Millisecond,
+	MaxWait:     time.Second,
+}
+
+func TestRetryServerError(t *testing.T) {
+	attempts := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	scraper.WithRetry(testRetryPolicy)
+	if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryAttemptsExhausted(t *testing.T) {
+	attempts := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	scraper.WithRetry(testRetryPolicy)
+	if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err == nil {
+		t.Error("Expected error after last attempt")
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	var last time.Time
+	attempts := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			last = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if time.Since(last) < time.Second {
+			t.Errorf("Expected retry after 1s, got %s", time.Since(last))
+		}
+		fmt.Fprint(w, `{}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	scraper.WithRetry(testRetryPolicy)
+	if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRetryNotFound(t *testing.T) {
+	attempts := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	scraper.WithRetry(testRetryPolicy)
+	if _, _, err := scraper.FetchSearchTweets("twitter", 20, ""); err == nil {
+		t.Error("Expected error")
+	}
+	if attempts != 1 {
+		t.Errorf("Expected single attempt, got %d", attempts)
+	}
+}
+
+func TestRetrySkipsPost(t *testing.T) {
+	attempts := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/1.1/lists/create.json", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+	scraper.WithCookie("auth_token=token; ct0=csrf")
+
+	scraper.WithRetry(testRetryPolicy)
+	if _, err := scraper.CreateList(twitterscraper.ListParams{Name: "monitor"}); err == nil {
+		t.Error("Expected error of failed POST")
+	}
+	if attempts != 1 {
+		t.Errorf("Expected POST sent once, got %d attempts", attempts)
+	}
+}
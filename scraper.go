@@ -1,8 +1,10 @@
 package twitterscraper
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"net/url"
@@ -13,18 +15,26 @@ import (
 	"golang.org/x/net/proxy"
 )
 
-// Scraper object
+// Scraper object.
+//
+// Scraper is safe for concurrent use by multiple goroutines:
+// settings and credentials are guarded by a mutex, guest tokens are
+// refreshed by a single request at a time and shared by all waiting callers.
 type Scraper struct {
+	mu sync.RWMutex
+
+	baseURLs       BaseURLs
 	bearerToken    string
 	client         *http.Client
-	delay          int64
-	guestToken     string
-	guestCreatedAt time.Time
+	endpoints      map[Endpoint]string
+	guestPool      *GuestTokenPool
 	includeReplies bool
+	limiter        Limiter
+	retryPolicy    RetryPolicy
 	searchMode     SearchMode
-	wg             sync.WaitGroup
+	sessionPool    *SessionPool
 
-	cookie     string
+	cookieJar  *cookieJar
 	xCsrfToken string
 }
 
@@ -51,24 +61,26 @@ var defaultScraper *Scraper
 
 // New creates a Scraper object
 func New() *Scraper {
+	jar := newCookieJar()
 	return &Scraper{
+		baseURLs:    DefaultBaseURLs,
 		bearerToken: bearerToken,
-		client:      &http.Client{Timeout: DefaultClientTimeout},
+		client:      &http.Client{Timeout: DefaultClientTimeout, Jar: jar},
+		cookieJar:   jar,
+		retryPolicy: DefaultRetryPolicy,
+		guestPool:   NewGuestTokenPool(1),
 	}
 }
 
-func (s *Scraper) setBearerToken(token string) {
-	s.bearerToken = token
-	s.guestToken = ""
-}
-
 // IsGuestToken check if guest token not empty
 func (s *Scraper) IsGuestToken() bool {
-	return s.guestToken != ""
+	return s.guestTokenPool().has(s.requestBearerToken(context.Background()))
 }
 
 // SetSearchMode switcher
 func (s *Scraper) SetSearchMode(mode SearchMode) *Scraper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.searchMode = mode
 	return s
 }
@@ -78,10 +90,13 @@ func SetSearchMode(mode SearchMode) *Scraper {
 	return defaultScraper.SetSearchMode(mode)
 }
 
-// WithDelay add delay between API requests (in seconds)
+// WithDelay add delay between API requests (in seconds),
+// shortcut of WithLimiter with a token bucket without bursts
 func (s *Scraper) WithDelay(seconds int64) *Scraper {
-	s.delay = seconds
-	return s
+	if seconds <= 0 {
+		return s.WithLimiter(nil)
+	}
+	return s.WithLimiter(NewTokenBucket(time.Duration(seconds)*time.Second, 1))
 }
 
 // Deprecated: WithDelay wrapper for default Scraper
@@ -91,6 +106,8 @@ func WithDelay(seconds int64) *Scraper {
 
 // WithReplies enable/disable load timeline with tweet replies
 func (s *Scraper) WithReplies(b bool) *Scraper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.includeReplies = b
 	return s
 }
@@ -100,34 +117,94 @@ func WithReplies(b bool) *Scraper {
 	return defaultScraper.WithReplies(b)
 }
 
-// cookie
+// WithCookie replace session cookies with cookies of raw `Cookie` header,
+// e.g. "auth_token=...; ct0=...". Cookies are set for API, Web and Caps base URLs
+// and copied to hosts set later by WithBaseURLs. Empty cookie clears session.
 func (s *Scraper) WithCookie(cookie string) *Scraper {
-	s.cookie = cookie
+	s.clearCookies()
+	header := http.Header{"Cookie": {cookie}}
+	cookies := (&http.Request{Header: header}).Cookies()
+	if len(cookies) == 0 {
+		return s
+	}
+	s.mu.RLock()
+	urls := []string{s.baseURLs.API, s.baseURLs.Web, s.baseURLs.Caps}
+	s.mu.RUnlock()
+	jar := s.jar()
+	for _, rawURL := range urls {
+		if u, err := url.Parse(rawURL); err == nil {
+			jar.SetCookies(u, cookies)
+		}
+	}
 	return s
 }
 
-// x csrf token
+// WithXCsrfToken override x-csrf-token header,
+// by default it is taken from ct0 cookie of session
 func (s *Scraper) WithXCsrfToken(xcsrfToken string) *Scraper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.xCsrfToken = xcsrfToken
 	return s
 }
 
+// isAuthenticated if session has auth_token cookie and csrf token
+func (s *Scraper) isAuthenticated() bool {
+	cookies := s.sessionCookies()
+	if cookies["auth_token"] == "" {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return cookies["ct0"] != "" || s.xCsrfToken != ""
+}
+
+// checkAuth return ErrAuthRequired if cookie authentication or session pool is not set
+func (s *Scraper) checkAuth() error {
+	if pool := s.sessions(); pool != nil && pool.Len() > 0 {
+		return nil
+	}
+	return s.checkCookieAuth()
+}
+
+// checkCookieAuth of Scraper cookie jar, ignoring session pool
+func (s *Scraper) checkCookieAuth() error {
+	if !s.isAuthenticated() {
+		return fmt.Errorf("auth_token or ct0 cookie not set: %w", ErrAuthRequired)
+	}
+	return nil
+}
+
 // client timeout
 func (s *Scraper) WithClientTimeout(timeout time.Duration) *Scraper {
-	s.client.Timeout = timeout
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	client := *s.client
+	client.Timeout = timeout
+	s.client = &client
 	return s
 }
 
+// httpClient return current HTTP client
+func (s *Scraper) httpClient() *http.Client {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.client
+}
+
 // SetProxy
 // set http proxy in the format `http://HOST:PORT`
 // set socket proxy in the format `socks5://HOST:PORT`
 func (s *Scraper) SetProxy(proxyAddr string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if strings.HasPrefix(proxyAddr, "http") {
 		urlproxy, err := url.Parse(proxyAddr)
 		if err != nil {
 			return err
 		}
 		s.client = &http.Client{
+			Jar: s.cookieJar,
 			Transport: &http.Transport{
 				Proxy:        http.ProxyURL(urlproxy),
 				TLSNextProto: make(map[string]func(authority string, c *tls.Conn) http.RoundTripper),
@@ -151,6 +228,7 @@ func (s *Scraper) SetProxy(proxyAddr string) error {
 		if contextDialer, ok := dialSocksProxy.(proxy.ContextDialer); ok {
 			dialContext := contextDialer.DialContext
 			s.client = &http.Client{
+				Jar: s.cookieJar,
 				Transport: &http.Transport{
 					DialContext: dialContext,
 				},
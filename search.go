@@ -7,7 +7,7 @@ import (
 
 // SearchTweets returns channel with tweets for a given search query
 func (s *Scraper) SearchTweets(ctx context.Context, query string, maxTweetsNbr int) <-chan *TweetResult {
-	return getTweetTimeline(ctx, query, maxTweetsNbr, s.FetchSearchTweets)
+	return getTweetTimeline(ctx, query, maxTweetsNbr, s.FetchSearchTweetsWithContext)
 }
 
 // Deprecated: SearchTweets wrapper for default Scraper
@@ -17,7 +17,7 @@ func SearchTweets(ctx context.Context, query string, maxTweetsNbr int) <-chan *T
 
 // SearchProfiles returns channel with profiles for a given search query
 func (s *Scraper) SearchProfiles(ctx context.Context, query string, maxProfilesNbr int) <-chan *ProfileResult {
-	return getUserTimeline(ctx, query, maxProfilesNbr, s.FetchSearchProfiles)
+	return getUserTimeline(ctx, query, maxProfilesNbr, s.FetchSearchProfilesWithContext)
 }
 
 // Deprecated: SearchProfiles wrapper for default Scraper
@@ -26,12 +26,12 @@ func SearchProfiles(ctx context.Context, query string, maxProfilesNbr int) <-cha
 }
 
 // getSearchTimeline gets results for a given search query, via the Twitter frontend API
-func (s *Scraper) getSearchTimeline(query string, maxNbr int, cursor string) (*timeline, error) {
+func (s *Scraper) getSearchTimeline(ctx context.Context, query string, searchMode SearchMode, maxNbr int, cursor string) (*timeline, error) {
 	if maxNbr > 50 {
 		maxNbr = 50
 	}
 
-	req, err := s.newRequest("GET", "https://twitter.com/i/api/2/search/adaptive.json")
+	req, err := s.newRequest(ctx, "GET", s.endpointURL(EndpointSearch))
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +45,7 @@ func (s *Scraper) getSearchTimeline(query string, maxNbr int, cursor string) (*t
 	if cursor != "" {
 		q.Add("cursor", cursor)
 	}
-	switch s.searchMode {
+	switch searchMode {
 	case SearchLatest:
 		q.Add("tweet_search_mode", "live")
 	case SearchPhotos:
@@ -68,7 +68,12 @@ func (s *Scraper) getSearchTimeline(query string, maxNbr int, cursor string) (*t
 
 // FetchSearchTweets gets tweets for a given search query, via the Twitter frontend API
 func (s *Scraper) FetchSearchTweets(query string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
-	timeline, err := s.getSearchTimeline(query, maxTweetsNbr, cursor)
+	return s.FetchSearchTweetsWithContext(context.Background(), query, maxTweetsNbr, cursor)
+}
+
+// FetchSearchTweetsWithContext gets tweets for a given search query, via the Twitter frontend API
+func (s *Scraper) FetchSearchTweetsWithContext(ctx context.Context, query string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	timeline, err := s.getSearchTimeline(ctx, query, s.getSearchMode(), maxTweetsNbr, cursor)
 	if err != nil {
 		return nil, "", err
 	}
@@ -78,10 +83,22 @@ func (s *Scraper) FetchSearchTweets(query string, maxTweetsNbr int, cursor strin
 
 // FetchSearchProfiles gets users for a given search query, via the Twitter frontend API
 func (s *Scraper) FetchSearchProfiles(query string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
-	timeline, err := s.getSearchTimeline(query, maxProfilesNbr, cursor)
+	return s.FetchSearchProfilesWithContext(context.Background(), query, maxProfilesNbr, cursor)
+}
+
+// FetchSearchProfilesWithContext gets users for a given search query, via the Twitter frontend API
+func (s *Scraper) FetchSearchProfilesWithContext(ctx context.Context, query string, maxProfilesNbr int, cursor string) ([]*Profile, string, error) {
+	timeline, err := s.getSearchTimeline(ctx, query, s.getSearchMode(), maxProfilesNbr, cursor)
 	if err != nil {
 		return nil, "", err
 	}
 	users, nextCursor := timeline.parseUsers()
 	return users, nextCursor, nil
 }
+
+// getSearchMode of Scraper
+func (s *Scraper) getSearchMode() SearchMode {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.searchMode
+}
@@ -0,0 +1,182 @@
+package twitterscraper
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultSessionCooldown of session rejected by API as unauthenticated
+const DefaultSessionCooldown = time.Hour
+
+// Session of authenticated account
+type Session struct {
+	// Name to identify account in health reports
+	Name string
+	// Cookie of raw `Cookie` header, e.g. "auth_token=...; ct0=..."
+	Cookie string
+	// XCsrfToken of requests, ct0 cookie if empty
+	XCsrfToken string
+}
+
+// SessionHealth of account in pool
+type SessionHealth struct {
+	Name          string
+	Requests      int
+	Failures      int
+	CooldownUntil time.Time
+	LastError     error
+}
+
+// Available if session is not cooling down
+func (h SessionHealth) Available() bool {
+	return time.Now().After(h.CooldownUntil)
+}
+
+// SessionPool of authenticated accounts, one is chosen per request in round-robin order
+// and sessions hitting rate limits or rejected by API cool down.
+// Pool is safe for concurrent use and can be shared by several Scrapers.
+type SessionPool struct {
+	mu       sync.Mutex
+	sessions []*pooledSession
+	next     int
+	cooldown time.Duration
+}
+
+type pooledSession struct {
+	Session
+	health SessionHealth
+}
+
+// NewSessionPool of sessions
+func NewSessionPool(sessions ...Session) *SessionPool {
+	p := &SessionPool{cooldown: DefaultSessionCooldown}
+	for _, session := range sessions {
+		p.Add(session)
+	}
+	return p
+}
+
+// WithCooldown set cooldown of sessions rejected as unauthenticated
+func (p *SessionPool) WithCooldown(cooldown time.Duration) *SessionPool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cooldown = cooldown
+	return p
+}
+
+// Add session to pool
+func (p *SessionPool) Add(session Session) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if session.Name == "" {
+		session.Name = fmt.Sprintf("session%d", len(p.sessions)+1)
+	}
+	if session.XCsrfToken == "" {
+		header := http.Header{"Cookie": {session.Cookie}}
+		if ct0, err := (&http.Request{Header: header}).Cookie("ct0"); err == nil {
+			session.XCsrfToken = ct0.Value
+		}
+	}
+	p.sessions = append(p.sessions, &pooledSession{
+		Session: session,
+		health:  SessionHealth{Name: session.Name},
+	})
+}
+
+// Len of sessions in pool
+func (p *SessionPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.sessions)
+}
+
+// Health report of every session
+func (p *SessionPool) Health() []SessionHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	health := make([]SessionHealth, 0, len(p.sessions))
+	for _, session := range p.sessions {
+		health = append(health, session.health)
+	}
+	return health
+}
+
+// pick next available session, requests are counted by use
+func (p *SessionPool) pick() (*pooledSession, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.sessions) == 0 {
+		return nil, fmt.Errorf("session pool is empty: %w", ErrAuthRequired)
+	}
+	var until time.Time
+	for i := 0; i < len(p.sessions); i++ {
+		session := p.sessions[(p.next+i)%len(p.sessions)]
+		if session.health.Available() {
+			p.next = (p.next + i + 1) % len(p.sessions)
+			return session, nil
+		}
+		if until.IsZero() || session.health.CooldownUntil.Before(until) {
+			until = session.health.CooldownUntil
+		}
+	}
+	return nil, &APIError{
+		StatusCode:     http.StatusTooManyRequests,
+		Status:         "429 Too Many Requests",
+		Body:           fmt.Sprintf("all sessions cool down until %s", until.Format(time.RFC3339)),
+		RateLimitReset: until,
+	}
+}
+
+// use session for request
+func (p *SessionPool) use(session *pooledSession) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	session.health.Requests++
+}
+
+// report result of request made with session
+func (p *SessionPool) report(session *pooledSession, resp *http.Response, err error) {
+	if err == nil || resp == nil {
+		return
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
+	case errors.Is(apiErr, ErrRateLimited):
+		until := apiErr.RateLimitReset
+		if until.IsZero() {
+			until = time.Now().Add(RateLimitWindow)
+		}
+		session.health.CooldownUntil = until
+	// 403 of content errors (already favorited, duplicate status, blocked...) keeps session in rotation
+	case resp.StatusCode == http.StatusUnauthorized || errors.Is(apiErr, ErrAuthRequired):
+		session.health.CooldownUntil = time.Now().Add(p.cooldown)
+	default:
+		return
+	}
+	session.health.Failures++
+	session.health.LastError = err
+}
+
+// WithSessionPool set pool of authenticated sessions,
+// it takes precedence over WithCookie and WithXCsrfToken
+func (s *Scraper) WithSessionPool(pool *SessionPool) *Scraper {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessionPool = pool
+	return s
+}
+
+func (s *Scraper) sessions() *SessionPool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.sessionPool
+}
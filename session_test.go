@@ -0,0 +1,110 @@
+package twitterscraper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+)
+
+func TestSessionPool(t *testing.T) {
+	requests := make(map[string]int)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/timeline/home.json", func(w http.ResponseWriter, r *http.Request) {
+		cookie := r.Header.Get("Cookie")
+		requests[cookie]++
+		if r.Header.Get("x-csrf-token") == "" {
+			t.Error("Expected x-csrf-token header")
+		}
+		switch cookie {
+		case "auth_token=limited":
+			w.Header().Set("X-Rate-Limit-Reset", fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"errors":[{"code":88,"message":"Rate limit exceeded"}]}`)
+		case "auth_token=locked":
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"errors":[{"code":32,"message":"Could not authenticate you."}]}`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	pool := twitterscraper.NewSessionPool(
+		twitterscraper.Session{Name: "limited", Cookie: "auth_token=limited", XCsrfToken: "1"},
+		twitterscraper.Session{Name: "locked", Cookie: "auth_token=locked", XCsrfToken: "2"},
+		twitterscraper.Session{Name: "ok", Cookie: "auth_token=ok", XCsrfToken: "3"},
+	)
+	scraper.WithSessionPool(pool).WithRetry(testRetryPolicy)
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := scraper.FetchHomeTimelineWithContext(context.Background(), "", 20, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests["auth_token=limited"] != 1 || requests["auth_token=locked"] != 1 || requests["auth_token=ok"] != 3 {
+		t.Errorf("Unexpected requests per session: %v", requests)
+	}
+
+	for _, health := range pool.Health() {
+		switch health.Name {
+		case "limited":
+			if health.Available() || !errors.Is(health.LastError, twitterscraper.ErrRateLimited) {
+				t.Errorf("Expected limited session to cool down: %+v", health)
+			}
+		case "locked":
+			if health.Available() || !errors.Is(health.LastError, twitterscraper.ErrAuthRequired) {
+				t.Errorf("Expected locked session to cool down: %+v", health)
+			}
+		case "ok":
+			if !health.Available() || health.Requests != 3 || health.Failures != 0 {
+				t.Errorf("Expected ok session to be healthy: %+v", health)
+			}
+		}
+	}
+}
+
+func TestSessionPoolContentError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/timeline/home.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errors":[{"code":139,"message":"You have already favorited this status."}]}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	pool := twitterscraper.NewSessionPool(twitterscraper.Session{Name: "ok", Cookie: "auth_token=ok", XCsrfToken: "1"})
+	scraper.WithSessionPool(pool).WithRetry(testRetryPolicy)
+
+	if _, _, err := scraper.FetchHomeTimelineWithContext(context.Background(), "", 20, ""); err == nil {
+		t.Fatal("Expected error of forbidden request")
+	}
+	if health := pool.Health()[0]; !health.Available() || health.Failures != 0 {
+		t.Errorf("Expected session to stay available after content error: %+v", health)
+	}
+}
+
+func TestSessionPoolCsrfFromCookie(t *testing.T) {
+	var csrf string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/timeline/home.json", func(w http.ResponseWriter, r *http.Request) {
+		csrf = r.Header.Get("x-csrf-token")
+		fmt.Fprint(w, `{}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	// x-csrf-token is taken from ct0 cookie like WithCookie
+	scraper.WithSessionPool(twitterscraper.NewSessionPool(twitterscraper.Session{Cookie: "auth_token=token; ct0=csrf"}))
+	if _, _, err := scraper.FetchHomeTimelineWithContext(context.Background(), "", 20, ""); err != nil {
+		t.Fatal(err)
+	}
+	if csrf != "csrf" {
+		t.Errorf("Expected x-csrf-token of ct0 cookie, got %q", csrf)
+	}
+}
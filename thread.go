@@ -0,0 +1,61 @@
+package twitterscraper
+
+import (
+	"context"
+	"strings"
+)
+
+// Thread of consecutive tweets posted by author in reply to themselves
+type Thread struct {
+	Tweets []*Tweet
+	// Text of tweets separated by blank lines
+	Text   string
+	Medias []Media
+	URLs   []string
+}
+
+// UnrollThread returns self-thread of conversation author starting from the root tweet.
+// If author replied to themselves several times, the branch containing tweetID is followed,
+// otherwise the earliest reply.
+func (s *Scraper) UnrollThread(ctx context.Context, tweetID string) (*Thread, error) {
+	root, err := s.GetConversation(ctx, tweetID)
+	if err != nil {
+		return nil, err
+	}
+
+	onPath := make(map[*ConversationNode]bool)
+	for node := root.Find(tweetID); node != nil; node = node.Parent {
+		onPath[node] = true
+	}
+
+	thread := &Thread{}
+	var texts []string
+	for node := root; node != nil; {
+		tweet := node.Tweet
+		thread.Tweets = append(thread.Tweets, tweet)
+		texts = append(texts, tweet.Text)
+		thread.Medias = append(thread.Medias, tweet.Medias...)
+		for _, u := range tweet.URLs {
+			if !stringInSlice(u, thread.URLs) {
+				thread.URLs = append(thread.URLs, u)
+			}
+		}
+
+		var next *ConversationNode
+		for _, child := range node.Children {
+			if child.Tweet.UserID != root.Tweet.UserID {
+				continue
+			}
+			if onPath[child] {
+				next = child
+				break
+			}
+			if next == nil {
+				next = child
+			}
+		}
+		node = next
+	}
+	thread.Text = strings.Join(texts, "\n\n")
+	return thread, nil
+}
@@ -24,170 +24,127 @@ type card struct {
 // timeline JSON object
 type timeline struct {
 	GlobalObjects struct {
-		Tweets map[string]struct {
-			ConversationIDStr string `json:"conversation_id_str"`
-			CreatedAt         string `json:"created_at"`
-			FavoriteCount     int    `json:"favorite_count"`
-			FullText          string `json:"full_text"`
-			Entities          struct {
-				Hashtags []struct {
-					Text string `json:"text"`
-				} `json:"hashtags"`
-				Media []struct {
-					MediaURLHttps string `json:"media_url_https"`
-					Type          string `json:"type"`
-					URL           string `json:"url"`
-				} `json:"media"`
-				URLs []struct {
-					ExpandedURL string `json:"expanded_url"`
-					URL         string `json:"url"`
-				} `json:"urls"`
-				UserMentions []struct {
-					ScreenName string `json:"screen_name"`
-					Name       string `json:"name"`
-					ID         int    `json:"id"`
-					IDStr      string `json:"id_str"`
-				} `json:"user_mentions"`
-			} `json:"entities"`
-			ExtendedEntities struct {
-				Media []struct {
-					IDStr                    string `json:"id_str"`
-					MediaURLHttps            string `json:"media_url_https"`
-					ExtSensitiveMediaWarning struct {
-						AdultContent    bool `json:"adult_content"`
-						GraphicViolence bool `json:"graphic_violence"`
-						Other           bool `json:"other"`
-					} `json:"ext_sensitive_media_warning"`
-					Type      string `json:"type"`
-					URL       string `json:"url"`
-					VideoInfo struct {
-						Variants []struct {
-							Bitrate int    `json:"bitrate,omitempty"`
-							URL     string `json:"url"`
-						} `json:"variants"`
-					} `json:"video_info"`
-					ExtAltText string `json:"ext_alt_text"`
-				} `json:"media"`
-			} `json:"extended_entities"`
-			InReplyToStatusIDStr string    `json:"in_reply_to_status_id_str"`
-			Place                Place     `json:"place"`
-			ReplyCount           int       `json:"reply_count"`
-			RetweetCount         int       `json:"retweet_count"`
-			RetweetedStatusIDStr string    `json:"retweeted_status_id_str"`
-			QuotedStatusIDStr    string    `json:"quoted_status_id_str"`
-			Time                 time.Time `json:"time"`
-			UserIDStr            string    `json:"user_id_str"`
-			Card                 struct {
-				BindingValues struct {
-					UnifiedCard struct {
-						Type        string `json:"type"`
-						StringValue string `json:"string_value"`
-					} `json:"unified_card"`
-				} `json:"binding_values"`
-			} `json:"card"`
-		} `json:"tweets"`
-		Users map[string]struct {
-			CreatedAt   string `json:"created_at"`
-			Description string `json:"description"`
-			Entities    struct {
-				Description struct {
-					Urls []struct {
-						ExpandedURL string `json:"expanded_url"`
-						URL         string `json:"url"`
-					} `json:"urls"`
-				} `json:"description"`
-				URL struct {
-					Urls []struct {
-						ExpandedURL string `json:"expanded_url"`
-					} `json:"urls"`
-				} `json:"url"`
-			} `json:"entities"`
-			FavouritesCount      int      `json:"favourites_count"`
-			FollowersCount       int      `json:"followers_count"`
-			Following            bool     `json:"following"`
-			FriendsCount         int      `json:"friends_count"`
-			IDStr                string   `json:"id_str"`
-			ListedCount          int      `json:"listed_count"`
-			Name                 string   `json:"name"`
-			Location             string   `json:"location"`
-			PinnedTweetIdsStr    []string `json:"pinned_tweet_ids_str"`
-			ProfileBannerURL     string   `json:"profile_banner_url"`
-			ProfileImageURLHTTPS string   `json:"profile_image_url_https"`
-			Protected            bool     `json:"protected"`
-			ScreenName           string   `json:"screen_name"`
-			StatusesCount        int      `json:"statuses_count"`
-			Verified             bool     `json:"verified"`
-		} `json:"users"`
+		Tweets        map[string]legacyTweet        `json:"tweets"`
+		Users         map[string]legacyUser         `json:"users"`
+		Notifications map[string]legacyNotification `json:"notifications"`
 	} `json:"globalObjects"`
 	Timeline struct {
-		Instructions []struct {
-			AddEntries struct {
-				Entries []struct {
-					Content struct {
-						Item struct {
-							Content struct {
-								Tweet struct {
-									ID            string       `json:"id"`
-									SocialContext *interface{} `json:"socialContext,omitempty"`
-								} `json:"tweet"`
-								User struct {
-									ID string `json:"id"`
-								} `json:"user"`
-							} `json:"content"`
-						} `json:"item"`
-						Operation struct {
-							Cursor struct {
-								Value      string `json:"value"`
-								CursorType string `json:"cursorType"`
-							} `json:"cursor"`
-						} `json:"operation"`
-						TimelineModule struct {
-							Items []struct {
-								Item struct {
-									ClientEventInfo struct {
-										Details struct {
-											GuideDetails struct {
-												TransparentGuideDetails struct {
-													TrendMetadata struct {
-														TrendName string `json:"trendName"`
-													} `json:"trendMetadata"`
-												} `json:"transparentGuideDetails"`
-											} `json:"guideDetails"`
-										} `json:"details"`
-									} `json:"clientEventInfo"`
-								} `json:"item"`
-							} `json:"items"`
-						} `json:"timelineModule"`
-					} `json:"content,omitempty"`
-				} `json:"entries"`
-			} `json:"addEntries"`
-			PinEntry struct {
-				Entry struct {
+		Instructions []timelineInstruction `json:"instructions"`
+	} `json:"timeline"`
+}
+
+// timelineInstruction of timeline JSON object
+type timelineInstruction struct {
+	AddEntries struct {
+		Entries []timelineEntry `json:"entries"`
+	} `json:"addEntries"`
+	PinEntry struct {
+		Entry struct {
+			Content struct {
+				Item struct {
 					Content struct {
-						Item struct {
-							Content struct {
-								Tweet struct {
-									ID string `json:"id"`
-								} `json:"tweet"`
-							} `json:"content"`
-						} `json:"item"`
+						Tweet struct {
+							ID string `json:"id"`
+						} `json:"tweet"`
 					} `json:"content"`
-				} `json:"entry"`
-			} `json:"pinEntry,omitempty"`
-			ReplaceEntry struct {
-				Entry struct {
+				} `json:"item"`
+			} `json:"content"`
+		} `json:"entry"`
+	} `json:"pinEntry,omitempty"`
+	ReplaceEntry struct {
+		Entry struct {
+			Content struct {
+				Operation struct {
+					Cursor struct {
+						Value      string `json:"value"`
+						CursorType string `json:"cursorType"`
+					} `json:"cursor"`
+				} `json:"operation"`
+			} `json:"content"`
+		} `json:"entry"`
+	} `json:"replaceEntry,omitempty"`
+}
+
+// timelineEntry of timeline instruction
+type timelineEntry struct {
+	Content struct {
+		Item struct {
+			Content struct {
+				Tweet struct {
+					ID            string       `json:"id"`
+					SocialContext *interface{} `json:"socialContext,omitempty"`
+				} `json:"tweet"`
+				User struct {
+					ID string `json:"id"`
+				} `json:"user"`
+				EventSummary timelineEventSummary `json:"eventSummary"`
+				Notification struct {
+					ID string `json:"id"`
+				} `json:"notification"`
+			} `json:"content"`
+		} `json:"item"`
+		Operation struct {
+			Cursor struct {
+				Value      string `json:"value"`
+				CursorType string `json:"cursorType"`
+			} `json:"cursor"`
+		} `json:"operation"`
+		TimelineModule struct {
+			Header struct {
+				Text string `json:"text"`
+			} `json:"header"`
+			Items []struct {
+				Item struct {
 					Content struct {
-						Operation struct {
-							Cursor struct {
-								Value      string `json:"value"`
-								CursorType string `json:"cursorType"`
-							} `json:"cursor"`
-						} `json:"operation"`
+						Tweet struct {
+							ID string `json:"id"`
+						} `json:"tweet"`
+						TimelineCursor struct {
+							Value      string `json:"value"`
+							CursorType string `json:"cursorType"`
+						} `json:"timelineCursor"`
+						Trend        timelineTrend        `json:"trend"`
+						EventSummary timelineEventSummary `json:"eventSummary"`
 					} `json:"content"`
-				} `json:"entry"`
-			} `json:"replaceEntry,omitempty"`
-		} `json:"instructions"`
-	} `json:"timeline"`
+					ClientEventInfo struct {
+						Details struct {
+							GuideDetails struct {
+								TransparentGuideDetails struct {
+									TrendMetadata struct {
+										TrendName string `json:"trendName"`
+									} `json:"trendMetadata"`
+								} `json:"transparentGuideDetails"`
+							} `json:"guideDetails"`
+						} `json:"details"`
+					} `json:"clientEventInfo"`
+				} `json:"item"`
+			} `json:"items"`
+		} `json:"timelineModule"`
+	} `json:"content,omitempty"`
+}
+
+// timelineTrend of guide module item
+type timelineTrend struct {
+	Name          string `json:"name"`
+	Rank          string `json:"rank"`
+	TrendMetadata struct {
+		DomainContext   string `json:"domainContext"`
+		MetaDescription string `json:"metaDescription"`
+	} `json:"trendMetadata"`
+	PromotedMetadata *interface{} `json:"promotedMetadata,omitempty"`
+}
+
+// timelineEventSummary of guide entry or module item
+type timelineEventSummary struct {
+	ID             string `json:"id"`
+	Title          string `json:"title"`
+	SupportingText string `json:"supportingText"`
+	Image          struct {
+		URL string `json:"url"`
+	} `json:"image"`
+	URL struct {
+		URL string `json:"url"`
+	} `json:"url"`
 }
 
 func (timeline *timeline) parseTweet(id string) *Tweet {
@@ -195,6 +152,8 @@ func (timeline *timeline) parseTweet(id string) *Tweet {
 		username := timeline.GlobalObjects.Users[tweet.UserIDStr].ScreenName
 		tw := &Tweet{
 			ID:           id,
+			IsLiked:      tweet.Favorited,
+			IsRetweeted:  tweet.Retweeted,
 			Likes:        tweet.FavoriteCount,
 			PermanentURL: fmt.Sprintf("https://twitter.com/%s/status/%s", username, id),
 			Replies:      tweet.ReplyCount,
@@ -1,12 +1,54 @@
 package twitterscraper
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
 
 var bearerToken2 = "AAAAAAAAAAAAAAAAAAAAANRILgAAAAAAnNwIzUejRCOuH5E6I8xnZz4puTs%3D1Zv7ttfk8LF81IUq16cHjhLTvJu4FA33AGWWjCpTnA"
 
+var reTweetVolume = regexp.MustCompile(`([\d.,]+)\s*([KM]?)`)
+
+// Trend of Twitter.
+type Trend struct {
+	Name string
+	// Query of trend search
+	Query string
+	// URL of trend search
+	URL string
+	// TweetVolume of last 24 hours, 0 if unknown
+	TweetVolume int
+	// Context of trend, e.g. "Trending in Sports"
+	Context    string
+	Rank       int
+	IsPromoted bool
+}
+
+// TrendLocation of trends, identified by Yahoo! WOEID.
+type TrendLocation struct {
+	Name        string `json:"name"`
+	WOEID       int64  `json:"woeid"`
+	ParentID    int64  `json:"parentid"`
+	Country     string `json:"country"`
+	CountryCode string `json:"countryCode"`
+	PlaceType   struct {
+		Code int    `json:"code"`
+		Name string `json:"name"`
+	} `json:"placeType"`
+}
+
 // GetTrends return list of trends.
 func (s *Scraper) GetTrends() ([]string, error) {
-	req, err := s.newRequest("GET", "https://twitter.com/i/api/2/guide.json")
+	return s.GetTrendsWithContext(context.Background())
+}
+
+// GetTrendsWithContext return list of trends.
+func (s *Scraper) GetTrendsWithContext(ctx context.Context) ([]string, error) {
+	req, err := s.newRequest(withBearerToken(ctx, bearerToken2), "GET", s.endpointURL(EndpointGuide))
 	if err != nil {
 		return nil, err
 	}
@@ -19,26 +61,152 @@ func (s *Scraper) GetTrends() ([]string, error) {
 	req.URL.RawQuery = q.Encode()
 
 	var jsn timeline
-	s.setBearerToken(bearerToken2)
 	err = s.RequestAPI(req, &jsn)
-	s.setBearerToken(bearerToken)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(jsn.Timeline.Instructions[1].AddEntries.Entries) < 2 {
-		return nil, fmt.Errorf("no trend entries found")
+	trends := jsn.parseTrends()
+	if len(trends) == 0 {
+		return nil, fmt.Errorf("no trend entries found: %w", ErrNotFound)
 	}
 
-	var trends []string
-	for _, item := range jsn.Timeline.Instructions[1].AddEntries.Entries[1].Content.TimelineModule.Items {
-		trends = append(trends, item.Item.ClientEventInfo.Details.GuideDetails.TransparentGuideDetails.TrendMetadata.TrendName)
+	names := make([]string, 0, len(trends))
+	for _, trend := range trends {
+		names = append(names, trend.Name)
 	}
-
-	return trends, nil
+	return names, nil
 }
 
 // Deprecated: GetTrends wrapper for default Scraper
 func GetTrends() ([]string, error) {
 	return defaultScraper.GetTrends()
 }
+
+// parseTrends of guide timeline modules, wherever they are placed
+func (timeline *timeline) parseTrends() []Trend {
+	var trends []Trend
+	for _, instruction := range timeline.Timeline.Instructions {
+		for _, entry := range instruction.AddEntries.Entries {
+			for _, item := range entry.Content.TimelineModule.Items {
+				name := item.Item.ClientEventInfo.Details.GuideDetails.TransparentGuideDetails.TrendMetadata.TrendName
+				if trend, ok := parseTrend(item.Item.Content.Trend, name, len(trends)+1); ok {
+					trends = append(trends, trend)
+				}
+			}
+		}
+	}
+	return trends
+}
+
+// parseTrend of module item, name and position are fallbacks for missing trend name and rank
+func parseTrend(content timelineTrend, name string, position int) (Trend, bool) {
+	if content.Name != "" {
+		name = content.Name
+	}
+	if name == "" {
+		return Trend{}, false
+	}
+	trend := newTrend(name, "")
+	trend.Context = content.TrendMetadata.DomainContext
+	trend.TweetVolume = parseTweetVolume(content.TrendMetadata.MetaDescription)
+	trend.IsPromoted = content.PromotedMetadata != nil
+	trend.Rank, _ = strconv.Atoi(content.Rank)
+	if trend.Rank == 0 {
+		trend.Rank = position
+	}
+	return trend, true
+}
+
+// newTrend with search query and URL
+func newTrend(name, query string) Trend {
+	if query == "" {
+		query = name
+	}
+	return Trend{
+		Name:  name,
+		Query: query,
+		URL:   "https://twitter.com/search?q=" + url.QueryEscape(query),
+	}
+}
+
+// parseTweetVolume of trend description, e.g. "12.5K Tweets"
+func parseTweetVolume(description string) int {
+	match := reTweetVolume.FindStringSubmatch(description)
+	if match == nil {
+		return 0
+	}
+	volume, err := strconv.ParseFloat(strings.Replace(match[1], ",", "", -1), 64)
+	if err != nil {
+		return 0
+	}
+	switch match[2] {
+	case "K":
+		volume *= 1e3
+	case "M":
+		volume *= 1e6
+	}
+	return int(volume)
+}
+
+// GetTrendsForLocation return trends of location by Yahoo! WOEID, 1 is worldwide.
+func (s *Scraper) GetTrendsForLocation(ctx context.Context, woeid int64) ([]Trend, error) {
+	req, err := s.newRequest(ctx, "GET", s.endpointURL(EndpointTrendsPlace))
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("id", strconv.FormatInt(woeid, 10))
+	req.URL.RawQuery = q.Encode()
+
+	var jsn []struct {
+		Trends []struct {
+			Name            string       `json:"name"`
+			Query           string       `json:"query"`
+			TweetVolume     int          `json:"tweet_volume"`
+			PromotedContent *interface{} `json:"promoted_content"`
+		} `json:"trends"`
+	}
+	err = s.RequestAPI(req, &jsn)
+	if err != nil {
+		return nil, err
+	}
+	if len(jsn) == 0 {
+		return nil, fmt.Errorf("trends of location %d not found: %w", woeid, ErrNotFound)
+	}
+
+	trends := make([]Trend, 0, len(jsn[0].Trends))
+	for i, t := range jsn[0].Trends {
+		query, err := url.QueryUnescape(t.Query)
+		if err != nil {
+			query = t.Query
+		}
+		trend := newTrend(t.Name, query)
+		trend.TweetVolume = t.TweetVolume
+		trend.Rank = i + 1
+		trend.IsPromoted = t.PromotedContent != nil
+		trends = append(trends, trend)
+	}
+	return trends, nil
+}
+
+// GetAvailableTrendLocations return locations with trends.
+func (s *Scraper) GetAvailableTrendLocations() ([]TrendLocation, error) {
+	return s.GetAvailableTrendLocationsWithContext(context.Background())
+}
+
+// GetAvailableTrendLocationsWithContext return locations with trends.
+func (s *Scraper) GetAvailableTrendLocationsWithContext(ctx context.Context) ([]TrendLocation, error) {
+	req, err := s.newRequest(ctx, "GET", s.endpointURL(EndpointTrendsAvailable))
+	if err != nil {
+		return nil, err
+	}
+
+	var locations []TrendLocation
+	err = s.RequestAPI(req, &locations)
+	if err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
@@ -1,9 +1,15 @@
 package twitterscraper_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
 	twitterscraper "github.com/JasonKhew96/twitter-scraper"
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestGetTrends(t *testing.T) {
@@ -23,3 +29,71 @@ func TestGetTrends(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTrendsModule(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/i/api/2/guide.json", func(w http.ResponseWriter, r *http.Request) {
+		// trends module is not at fixed position
+		fmt.Fprint(w, `{"timeline":{"instructions":[{"addEntries":{"entries":[`+
+			`{"entryId":"trends","content":{"timelineModule":{"items":[`+
+			`{"entryId":"trends-1","item":{"content":{"trend":{"name":"#Go","rank":"1",`+
+			`"trendMetadata":{"domainContext":"Trending in Technology","metaDescription":"12.5K Tweets"}}}}},`+
+			`{"entryId":"trends-2","item":{"content":{"trend":{"name":"Gophers","rank":"2","promotedMetadata":{}}}}}]}}}]}}]}}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	trends, err := scraper.GetTrends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(trends, ",") != "#Go,Gophers" {
+		t.Errorf("Unexpected trends %v", trends)
+	}
+
+	mux = http.NewServeMux()
+	mux.HandleFunc("/i/api/2/guide.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"timeline":{"instructions":[]}}`)
+	})
+	srv, scraper = newTestServer(mux)
+	defer srv.Close()
+	if _, err := scraper.GetTrends(); !errors.Is(err, twitterscraper.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound without trends, got %v", err)
+	}
+}
+
+func TestGetTrendsForLocation(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/1.1/trends/place.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") != "23424977" {
+			t.Errorf("Expected WOEID 23424977, got %s", r.URL.Query().Get("id"))
+		}
+		fmt.Fprint(w, `[{"trends":[{"name":"#Go","url":"http://twitter.com/search?q=%23Go","query":"%23Go","tweet_volume":12500},`+
+			`{"name":"Gophers","query":"Gophers","tweet_volume":null,"promoted_content":{}}],"locations":[{"name":"United States","woeid":23424977}]}]`)
+	})
+	mux.HandleFunc("/1.1/trends/available.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"Worldwide","woeid":1,"parentid":0,"country":"","countryCode":null,"placeType":{"code":19,"name":"Supername"}}]`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	trends, err := scraper.GetTrendsForLocation(context.Background(), 23424977)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []twitterscraper.Trend{
+		{Name: "#Go", Query: "#Go", URL: "https://twitter.com/search?q=%23Go", TweetVolume: 12500, Rank: 1},
+		{Name: "Gophers", Query: "Gophers", URL: "https://twitter.com/search?q=Gophers", Rank: 2, IsPromoted: true},
+	}
+	if diff := cmp.Diff(expected, trends); diff != "" {
+		t.Error("Resulting trends does not match the sample", diff)
+	}
+
+	locations, err := scraper.GetAvailableTrendLocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locations) != 1 || locations[0].WOEID != 1 || locations[0].PlaceType.Name != "Supername" {
+		t.Errorf("Unexpected locations %+v", locations)
+	}
+}
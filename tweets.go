@@ -8,17 +8,30 @@ import (
 
 // GetTweets returns channel with tweets for a given user.
 func (s *Scraper) GetTweets(ctx context.Context, user string, maxTweetsNbr int) <-chan *TweetResult {
-	return getTweetTimeline(ctx, user, maxTweetsNbr, s.FetchTweets)
+	return getTweetTimeline(ctx, user, maxTweetsNbr, s.FetchTweetsWithContext)
 }
 
 // GetHomeTimeline returns channel with tweets from home timeline.
 func (s *Scraper) GetHomeTimeline(ctx context.Context, maxTweetsNbr int) <-chan *TweetResult {
-	return getTweetTimeline(ctx, "", maxTweetsNbr, s.FetchHomeTimeline)
+	return getTweetTimeline(ctx, "", maxTweetsNbr, s.FetchHomeTimelineWithContext)
 }
 
 // GetHomeLatestTimeline returns channel with tweets from home latest timeline.
 func (s *Scraper) GetHomeLatestTimeline(ctx context.Context, maxTweetsNbr int) <-chan *TweetResult {
-	return getTweetTimeline(ctx, "", maxTweetsNbr, s.FetchHomeLatestTimeline)
+	return getTweetTimeline(ctx, "", maxTweetsNbr, s.FetchHomeLatestTimelineWithContext)
+}
+
+// GetMediaTweets returns channel with tweets with photos and videos for a given user.
+func (s *Scraper) GetMediaTweets(ctx context.Context, user string, maxTweetsNbr int) <-chan *TweetResult {
+	return getTweetTimeline(ctx, user, maxTweetsNbr, s.FetchMediaTweetsWithContext)
+}
+
+// GetQuoteTweets returns channel with tweets quoting a given tweet.
+func (s *Scraper) GetQuoteTweets(ctx context.Context, tweetID string, maxTweetsNbr int) <-chan *TweetResult {
+	quoted := &quotedTweet{}
+	return getTweetTimeline(ctx, tweetID, maxTweetsNbr, func(ctx context.Context, tweetID string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+		return s.fetchQuoteTweets(ctx, tweetID, maxTweetsNbr, cursor, quoted)
+	})
 }
 
 // Deprecated: GetTweets wrapper for default Scraper
@@ -28,16 +41,21 @@ func GetTweets(ctx context.Context, user string, maxTweetsNbr int) <-chan *Tweet
 
 // FetchTweets gets tweets for a given user, via the Twitter frontend API.
 func (s *Scraper) FetchTweets(user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.FetchTweetsWithContext(context.Background(), user, maxTweetsNbr, cursor)
+}
+
+// FetchTweetsWithContext gets tweets for a given user, via the Twitter frontend API.
+func (s *Scraper) FetchTweetsWithContext(ctx context.Context, user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
 	if maxTweetsNbr > 20 {
 		maxTweetsNbr = 20
 	}
 
-	userID, err := s.GetUserIDByScreenName(user)
+	userID, err := s.GetUserIDByScreenNameWithContext(ctx, user)
 	if err != nil {
 		return nil, "", err
 	}
 
-	req, err := s.newRequest("GET", "https://api.twitter.com/2/timeline/profile/"+userID+".json")
+	req, err := s.newRequest(ctx, "GET", s.endpointURL(EndpointProfileTimeline, userID))
 	if err != nil {
 		return nil, "", err
 	}
@@ -60,17 +78,93 @@ func (s *Scraper) FetchTweets(user string, maxTweetsNbr int, cursor string) ([]*
 	return tweets, nextCursor, nil
 }
 
+// FetchMediaTweets gets tweets with photos and videos for a given user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchMediaTweets(user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.FetchMediaTweetsWithContext(context.Background(), user, maxTweetsNbr, cursor)
+}
+
+// FetchMediaTweetsWithContext gets tweets with photos and videos for a given user, via the Twitter frontend GraphQL API.
+func (s *Scraper) FetchMediaTweetsWithContext(ctx context.Context, user string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if maxTweetsNbr > 20 {
+		maxTweetsNbr = 20
+	}
+
+	timeline, err := s.fetchUserTimeline(ctx, EndpointUserMedia, user, maxTweetsNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tweets, nextCursor := timeline.toTimeline().parseTweets()
+	return tweets, nextCursor, nil
+}
+
+// FetchQuoteTweets gets tweets quoting a given tweet, via the Twitter frontend API search.
+func (s *Scraper) FetchQuoteTweets(tweetID string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.FetchQuoteTweetsWithContext(context.Background(), tweetID, maxTweetsNbr, cursor)
+}
+
+// FetchQuoteTweetsWithContext gets tweets quoting a given tweet, via the Twitter frontend API search.
+// QuotedStatus of tweets is loaded if missing in search results, it is nil if quoted tweet can't be fetched.
+func (s *Scraper) FetchQuoteTweetsWithContext(ctx context.Context, tweetID string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	return s.fetchQuoteTweets(ctx, tweetID, maxTweetsNbr, cursor, &quotedTweet{})
+}
+
+// quotedTweet shared by pages of quote tweets, so it is fetched once
+type quotedTweet struct {
+	tweet  *Tweet
+	loaded bool
+}
+
+// load quoted tweet on first call, nil if it can't be fetched, e.g. deleted or protected
+func (q *quotedTweet) load(ctx context.Context, s *Scraper, id string) *Tweet {
+	if !q.loaded {
+		q.loaded = true
+		if tweet, err := s.GetTweetWithContext(ctx, id); err == nil {
+			q.tweet = tweet
+		}
+	}
+	return q.tweet
+}
+
+func (s *Scraper) fetchQuoteTweets(ctx context.Context, tweetID string, maxTweetsNbr int, cursor string, quoted *quotedTweet) ([]*Tweet, string, error) {
+	timeline, err := s.getSearchTimeline(ctx, "quoted_tweet_id:"+tweetID, SearchLatest, maxTweetsNbr, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	tweets, nextCursor := timeline.parseTweets()
+
+	// quoted tweet included in search results saves the request
+	for _, tweet := range tweets {
+		if tweet.QuotedStatus != nil && !quoted.loaded {
+			quoted.tweet = tweet.QuotedStatus
+			quoted.loaded = true
+		}
+	}
+	for _, tweet := range tweets {
+		if tweet.QuotedStatus == nil {
+			tweet.IsQuoted = true
+			tweet.QuotedStatus = quoted.load(ctx, s, tweetID)
+		}
+	}
+	return tweets, nextCursor, nil
+}
+
 // FetchHomeTimeline get tweets from home timeline.
 func (s *Scraper) FetchHomeTimeline(_ string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
-	if s.xCsrfToken == "" || s.cookie == "" {
-		return nil, "", fmt.Errorf("xCsrfToken or cookie not set")
+	return s.FetchHomeTimelineWithContext(context.Background(), "", maxTweetsNbr, cursor)
+}
+
+// FetchHomeTimelineWithContext get tweets from home timeline.
+func (s *Scraper) FetchHomeTimelineWithContext(ctx context.Context, _ string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, "", err
 	}
 
 	if maxTweetsNbr > 200 {
 		maxTweetsNbr = 200
 	}
 
-	req, err := s.newRequest("GET", "https://twitter.com/i/api/2/timeline/home.json")
+	req, err := s.newRequest(ctx, "GET", s.endpointURL(EndpointHomeTimeline))
 	if err != nil {
 		return nil, "", err
 	}
@@ -94,15 +188,20 @@ func (s *Scraper) FetchHomeTimeline(_ string, maxTweetsNbr int, cursor string) (
 
 // FetchHomeLatestTimeline get tweets from home timeline.
 func (s *Scraper) FetchHomeLatestTimeline(_ string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
-	if s.xCsrfToken == "" || s.cookie == "" {
-		return nil, "", fmt.Errorf("xCsrfToken or cookie not set")
+	return s.FetchHomeLatestTimelineWithContext(context.Background(), "", maxTweetsNbr, cursor)
+}
+
+// FetchHomeLatestTimelineWithContext get tweets from home timeline.
+func (s *Scraper) FetchHomeLatestTimelineWithContext(ctx context.Context, _ string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error) {
+	if err := s.checkAuth(); err != nil {
+		return nil, "", err
 	}
 
 	if maxTweetsNbr > 200 {
 		maxTweetsNbr = 200
 	}
 
-	req, err := s.newRequest("GET", "https://twitter.com/i/api/2/timeline/home_latest.json")
+	req, err := s.newRequest(ctx, "GET", s.endpointURL(EndpointHomeLatestTimeline))
 	if err != nil {
 		return nil, "", err
 	}
@@ -126,13 +225,12 @@ func (s *Scraper) FetchHomeLatestTimeline(_ string, maxTweetsNbr int, cursor str
 
 // GetTweet get a single tweet by ID.
 func (s *Scraper) GetTweet(id string) (*Tweet, error) {
-	req, err := s.newRequest("GET", "https://twitter.com/i/api/2/timeline/conversation/"+id+".json")
-	if err != nil {
-		return nil, err
-	}
+	return s.GetTweetWithContext(context.Background(), id)
+}
 
-	var timeline timeline
-	err = s.RequestAPI(req, &timeline)
+// GetTweetWithContext get a single tweet by ID.
+func (s *Scraper) GetTweetWithContext(ctx context.Context, id string) (*Tweet, error) {
+	timeline, err := s.fetchConversation(ctx, id, "")
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +241,7 @@ func (s *Scraper) GetTweet(id string) (*Tweet, error) {
 			return tweet, nil
 		}
 	}
-	return nil, fmt.Errorf("tweet with ID %s not found", id)
+	return nil, fmt.Errorf("tweet with ID %s not found: %w", id, ErrNotFound)
 }
 
 // Deprecated: GetTweet wrapper for default Scraper
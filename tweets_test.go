@@ -2,6 +2,9 @@ package twitterscraper_test
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -178,3 +181,116 @@ func TestRetweet(t *testing.T) {
 		}
 	}
 }
+
+func TestGetMediaTweets(t *testing.T) {
+	mux := http.NewServeMux()
+	handleUserByScreenName(mux, "media_user", "45")
+	mux.HandleFunc("/graphql/Le6KlbilFmSu-5VltFND-Q/UserMedia", func(w http.ResponseWriter, r *http.Request) {
+		var instruction string
+		variables := r.URL.Query().Get("variables")
+		if strings.Contains(variables, `"cursor":"page3"`) {
+			instruction = tweetEntries("page4")
+		} else if strings.Contains(variables, `"cursor":"page2"`) {
+			// next pages of media grid are added to module
+			instruction = fmt.Sprintf(`{"type":"TimelineAddToModule","moduleItems":[{"entryId":"grid-2","item":{"itemContent":`+
+				`{"itemType":"TimelineTweet","tweet_results":{"result":%s}}}}]},%s`, tweetResult("202", "grid"), tweetEntries("page3"))
+		} else {
+			instruction = fmt.Sprintf(`{"type":"TimelineAddEntries","entries":[{"entryId":"profile-grid-0","content":{"entryType":"TimelineTimelineModule",`+
+				`"items":[{"entryId":"grid-0","item":{"itemContent":{"itemType":"TimelineTweet","tweet_results":{"result":%s}}}},`+
+				`{"entryId":"grid-1","item":{"itemContent":{"itemType":"TimelineTweet","tweet_results":{"result":%s}}}}]}}]},%s`,
+				tweetResult("200", "grid"), tweetResult("201", "grid"), tweetEntries("page2"))
+		}
+		fmt.Fprintf(w, `{"data":{"user":{"result":{"__typename":"User","timeline_v2":{"timeline":{"instructions":[%s]}}}}}}`, instruction)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	var ids []string
+	for tweet := range scraper.GetMediaTweets(context.Background(), "media_user", 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		photo, ok := tweet.Medias[0].(twitterscraper.MediaPhoto)
+		if len(tweet.Medias) != 1 || !ok || !strings.HasSuffix(photo.Url, tweet.ID+".jpg") {
+			t.Errorf("Unexpected medias %v", tweet.Medias)
+		}
+		ids = append(ids, tweet.ID)
+	}
+	if strings.Join(ids, ",") != "200,201,202" {
+		t.Errorf("Expected 200,201,202, got %v", ids)
+	}
+}
+
+// handleQuoteSearch serve two pages of tweets quoting tweet 400, included in search results for none of them
+func handleQuoteSearch(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/i/api/2/search/adaptive.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") != "quoted_tweet_id:400" || r.URL.Query().Get("tweet_search_mode") != "live" {
+			t.Errorf("Unexpected search query %s", r.URL.RawQuery)
+		}
+		ids := map[string][]string{"": {"401", "402"}, "401-next": {"403"}}[r.URL.Query().Get("cursor")]
+		var objects, entries []string
+		for _, id := range ids {
+			objects = append(objects, fmt.Sprintf(`%q:{"full_text":"quote","user_id_str":"20","quoted_status_id_str":"400"}`, id))
+			entries = append(entries, fmt.Sprintf(`{"entryId":"sq-I-t-%s","content":{"item":{"content":{"tweet":{"id":%[1]q}}}}}`, id))
+		}
+		if len(ids) > 0 {
+			entries = append(entries, fmt.Sprintf(`{"entryId":"sq-cursor-bottom","content":{"operation":{"cursor":{"value":"%s-next","cursorType":"Bottom"}}}}`, ids[0]))
+		}
+		fmt.Fprint(w, `{"globalObjects":{"tweets":{`+strings.Join(objects, ",")+`},"users":{"20":{"screen_name":"bob"}}},`+
+			`"timeline":{"instructions":[{"addEntries":{"entries":[`+strings.Join(entries, ",")+`]}}]}}`)
+	})
+}
+
+func TestGetQuoteTweets(t *testing.T) {
+	mux := http.NewServeMux()
+	handleQuoteSearch(t, mux)
+	var conversationRequests int
+	mux.HandleFunc("/i/api/2/timeline/conversation/400.json", func(w http.ResponseWriter, r *http.Request) {
+		conversationRequests++
+		fmt.Fprint(w, conversationPage([]conversationTweet{{id: "400", user: "10", text: "original"}}, "", ""))
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	var ids []string
+	for tweet := range scraper.GetQuoteTweets(context.Background(), "400", 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if !tweet.IsQuoted || tweet.QuotedStatus == nil || tweet.QuotedStatus.Text != "original" {
+			t.Errorf("Expected QuotedStatus of tweet %s, got %+v", tweet.ID, tweet.QuotedStatus)
+		}
+		ids = append(ids, tweet.ID)
+	}
+	if strings.Join(ids, ",") != "401,402,403" {
+		t.Errorf("Expected 401,402,403, got %v", ids)
+	}
+	if conversationRequests != 1 {
+		t.Errorf("Expected quoted tweet fetched once, got %d requests", conversationRequests)
+	}
+}
+
+func TestGetQuoteTweetsOfDeletedTweet(t *testing.T) {
+	mux := http.NewServeMux()
+	handleQuoteSearch(t, mux)
+	mux.HandleFunc("/i/api/2/timeline/conversation/400.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors":[{"code":144,"message":"No status found with that ID."}]}`)
+	})
+	srv, scraper := newTestServer(mux)
+	defer srv.Close()
+
+	var ids []string
+	for tweet := range scraper.GetQuoteTweets(context.Background(), "400", 10) {
+		if tweet.Error != nil {
+			t.Fatal(tweet.Error)
+		}
+		if !tweet.IsQuoted || tweet.QuotedStatus != nil {
+			t.Errorf("Expected quote tweet %s without QuotedStatus, got %+v", tweet.ID, tweet.QuotedStatus)
+		}
+		ids = append(ids, tweet.ID)
+	}
+	if strings.Join(ids, ",") != "401,402,403" {
+		t.Errorf("Expected 401,402,403, got %v", ids)
+	}
+}
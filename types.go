@@ -1,6 +1,9 @@
 package twitterscraper
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 type (
 	// Media type
@@ -31,6 +34,8 @@ type (
 		IsReply          bool
 		IsRetweet        bool
 		IsRecommended    bool
+		IsLiked          bool
+		IsRetweeted      bool
 		Likes            int
 		Mentions         []string
 		PermanentURL     string
@@ -61,6 +66,83 @@ type (
 		Error error
 	}
 
+	// ListResult of scrapping.
+	ListResult struct {
+		List
+		Error error
+	}
+
+	// NotificationResult of scrapping.
+	NotificationResult struct {
+		Notification
+		Error error
+	}
+
+	legacyTweet struct {
+		ConversationIDStr string `json:"conversation_id_str"`
+		CreatedAt         string `json:"created_at"`
+		FavoriteCount     int    `json:"favorite_count"`
+		Favorited         bool   `json:"favorited"`
+		Retweeted         bool   `json:"retweeted"`
+		FullText          string `json:"full_text"`
+		Entities          struct {
+			Hashtags []struct {
+				Text string `json:"text"`
+			} `json:"hashtags"`
+			Media []struct {
+				MediaURLHttps string `json:"media_url_https"`
+				Type          string `json:"type"`
+				URL           string `json:"url"`
+			} `json:"media"`
+			URLs []struct {
+				ExpandedURL string `json:"expanded_url"`
+				URL         string `json:"url"`
+			} `json:"urls"`
+			UserMentions []struct {
+				ScreenName string `json:"screen_name"`
+				Name       string `json:"name"`
+				ID         int    `json:"id"`
+				IDStr      string `json:"id_str"`
+			} `json:"user_mentions"`
+		} `json:"entities"`
+		ExtendedEntities struct {
+			Media []struct {
+				IDStr                    string `json:"id_str"`
+				MediaURLHttps            string `json:"media_url_https"`
+				ExtSensitiveMediaWarning struct {
+					AdultContent    bool `json:"adult_content"`
+					GraphicViolence bool `json:"graphic_violence"`
+					Other           bool `json:"other"`
+				} `json:"ext_sensitive_media_warning"`
+				Type      string `json:"type"`
+				URL       string `json:"url"`
+				VideoInfo struct {
+					Variants []struct {
+						Bitrate int    `json:"bitrate,omitempty"`
+						URL     string `json:"url"`
+					} `json:"variants"`
+				} `json:"video_info"`
+				ExtAltText string `json:"ext_alt_text"`
+			} `json:"media"`
+		} `json:"extended_entities"`
+		InReplyToStatusIDStr string    `json:"in_reply_to_status_id_str"`
+		Place                Place     `json:"place"`
+		ReplyCount           int       `json:"reply_count"`
+		RetweetCount         int       `json:"retweet_count"`
+		RetweetedStatusIDStr string    `json:"retweeted_status_id_str"`
+		QuotedStatusIDStr    string    `json:"quoted_status_id_str"`
+		Time                 time.Time `json:"time"`
+		UserIDStr            string    `json:"user_id_str"`
+		Card                 struct {
+			BindingValues struct {
+				UnifiedCard struct {
+					Type        string `json:"type"`
+					StringValue string `json:"string_value"`
+				} `json:"unified_card"`
+			} `json:"binding_values"`
+		} `json:"card"`
+	}
+
 	legacyUser struct {
 		CreatedAt   string `json:"created_at"`
 		Description string `json:"description"`
@@ -107,6 +189,8 @@ type (
 		} `json:"bounding_box"`
 	}
 
-	fetchProfileFunc func(query string, maxProfilesNbr int, cursor string) ([]*Profile, string, error)
-	fetchTweetFunc   func(query string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error)
+	fetchProfileFunc      func(ctx context.Context, query string, maxProfilesNbr int, cursor string) ([]*Profile, string, error)
+	fetchTweetFunc        func(ctx context.Context, query string, maxTweetsNbr int, cursor string) ([]*Tweet, string, error)
+	fetchListFunc         func(ctx context.Context, query string, maxListsNbr int, cursor string) ([]*List, string, error)
+	fetchNotificationFunc func(ctx context.Context, query string, maxNotificationsNbr int, cursor string) ([]*Notification, string, error)
 )
@@ -16,8 +16,8 @@ var (
 	reUsername   = regexp.MustCompile(`\B(\@\S{1,15}\b)`)
 )
 
-func (s *Scraper) newRequest(method string, url string) (*http.Request, error) {
-	req, err := http.NewRequest(method, url, nil)
+func (s *Scraper) newRequest(ctx context.Context, method string, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +46,9 @@ func (s *Scraper) newRequest(method string, url string) (*http.Request, error) {
 	q.Add("include_ext_sensitive_media_warning", "true")
 	q.Add("send_error_codes", "true")
 	q.Add("simple_quoted_tweet", "true")
+	s.mu.RLock()
 	q.Add("include_tweet_replies", strconv.FormatBool(s.includeReplies))
+	s.mu.RUnlock()
 	q.Add("ext", "mediaStats,highlightedLabel,hasNftAvatar,voiceInfo,superFollowMetadata")
 	req.URL.RawQuery = q.Encode()
 
@@ -67,7 +69,7 @@ func getUserTimeline(ctx context.Context, query string, maxProfilesNbr int, fetc
 			default:
 			}
 
-			profiles, next, err := fetchFunc(query, maxProfilesNbr, nextCursor)
+			profiles, next, err := fetchFunc(ctx, query, maxProfilesNbr, nextCursor)
 			if err != nil {
 				channel <- &ProfileResult{Error: err}
 				return
@@ -112,7 +114,7 @@ func getTweetTimeline(ctx context.Context, query string, maxTweetsNbr int, fetch
 			default:
 			}
 
-			tweets, next, err := fetchFunc(query, maxTweetsNbr, nextCursor)
+			tweets, next, err := fetchFunc(ctx, query, maxTweetsNbr, nextCursor)
 			if err != nil {
 				channel <- &TweetResult{Error: err}
 				return
@@ -146,6 +148,96 @@ func getTweetTimeline(ctx context.Context, query string, maxTweetsNbr int, fetch
 	return channel
 }
 
+func getListTimeline(ctx context.Context, query string, maxListsNbr int, fetchFunc fetchListFunc) <-chan *ListResult {
+	channel := make(chan *ListResult)
+	go func(query string) {
+		defer close(channel)
+		var nextCursor string
+		listsNbr := 0
+		for listsNbr < maxListsNbr {
+			select {
+			case <-ctx.Done():
+				channel <- &ListResult{Error: ctx.Err()}
+				return
+			default:
+			}
+
+			lists, next, err := fetchFunc(ctx, query, maxListsNbr, nextCursor)
+			if err != nil {
+				channel <- &ListResult{Error: err}
+				return
+			}
+
+			if len(lists) == 0 {
+				break
+			}
+
+			for _, list := range lists {
+				select {
+				case <-ctx.Done():
+					channel <- &ListResult{Error: ctx.Err()}
+					return
+				default:
+				}
+
+				if listsNbr < maxListsNbr {
+					nextCursor = next
+					channel <- &ListResult{List: *list}
+				} else {
+					break
+				}
+				listsNbr++
+			}
+		}
+	}(query)
+	return channel
+}
+
+func getNotificationTimeline(ctx context.Context, query string, maxNotificationsNbr int, fetchFunc fetchNotificationFunc) <-chan *NotificationResult {
+	channel := make(chan *NotificationResult)
+	go func(query string) {
+		defer close(channel)
+		var nextCursor string
+		notificationsNbr := 0
+		for notificationsNbr < maxNotificationsNbr {
+			select {
+			case <-ctx.Done():
+				channel <- &NotificationResult{Error: ctx.Err()}
+				return
+			default:
+			}
+
+			notifications, next, err := fetchFunc(ctx, query, maxNotificationsNbr, nextCursor)
+			if err != nil {
+				channel <- &NotificationResult{Error: err}
+				return
+			}
+
+			if len(notifications) == 0 {
+				break
+			}
+
+			for _, notification := range notifications {
+				select {
+				case <-ctx.Done():
+					channel <- &NotificationResult{Error: ctx.Err()}
+					return
+				default:
+				}
+
+				if notificationsNbr < maxNotificationsNbr {
+					nextCursor = next
+					channel <- &NotificationResult{Notification: *notification}
+				} else {
+					break
+				}
+				notificationsNbr++
+			}
+		}
+	}(query)
+	return channel
+}
+
 func parseProfile(user legacyUser) Profile {
 	profile := Profile{
 		Avatar:         user.ProfileImageURLHTTPS,